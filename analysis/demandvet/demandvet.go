@@ -0,0 +1,311 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package demandvet defines an Analyzer that reports misuse of demand
+// assertions:
+//
+//   - constant passed as expected value after a non-constant actual value,
+//     which usually means the arguments are swapped
+//   - calls of require functions in goroutines started by the test, which
+//     can't stop the test (t.FailNow must be called from the test goroutine),
+//     unless they are given a TestingT (or Assertions) made by Sync
+//   - errors compared with Equal, where ErrorIs should be used
+//   - useless assertions such as Equal(t, x, x)
+//
+// It can be run with go vet using the demandvet command:
+//
+//	go install github.com/ilius/demand/cmd/demandvet@latest
+//	go vet -vettool=$(which demandvet) ./...
+package demandvet
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const doc = `report misuse of demand assertions
+
+The demandvet analyzer reports swapped expected/actual arguments, require
+calls from goroutines other than the test goroutine (except with Sync),
+errors compared with Equal instead of ErrorIs, and useless assertions like
+Equal(t, x, x).`
+
+// Analyzer reports misuse of demand assertions.
+var Analyzer = &analysis.Analyzer{
+	Name:     "demandvet",
+	Doc:      doc,
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+const (
+	requirePath = "github.com/ilius/demand/require"
+	checkPath   = "github.com/ilius/demand/check"
+)
+
+// equalityFuncs are assertions that compare expected and actual values
+// for equality, where errors should be compared with ErrorIs instead.
+var equalityFuncs = map[string]bool{
+	"Equal":        true,
+	"Equalf":       true,
+	"EqualValues":  true,
+	"EqualValuesf": true,
+	"Exactly":      true,
+	"Exactlyf":     true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ValueSpec)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.GoStmt)(nil),
+	}
+	synced := syncedVars{}
+	insp.Preorder(nodeFilter, func(node ast.Node) {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) == len(node.Rhs) {
+				for i, lhs := range node.Lhs {
+					synced.assign(pass, lhs, node.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			if len(node.Names) == len(node.Values) {
+				for i, name := range node.Names {
+					synced.assign(pass, name, node.Values[i])
+				}
+			}
+		case *ast.CallExpr:
+			checkCall(pass, node)
+		case *ast.GoStmt:
+			checkGoStmt(pass, node, synced)
+		}
+	})
+	return nil, nil
+}
+
+// syncedVars are the variables holding a TestingT made by require.Sync,
+// or Assertions made by the Sync method or by New from such a TestingT,
+// which can be used from any goroutine.
+type syncedVars map[types.Object]bool
+
+// assign records the variable lhs if value is synced.
+func (synced syncedVars) assign(pass *analysis.Pass, lhs ast.Expr, value ast.Expr) {
+	ident, ok := unparen(lhs).(*ast.Ident)
+	if !ok {
+		return
+	}
+	if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+		synced[obj] = synced.is(pass, value)
+	}
+}
+
+// is reports if expr is a TestingT or Assertions that can be used from
+// any goroutine, see Sync.
+func (synced syncedVars) is(pass *analysis.Pass, expr ast.Expr) bool {
+	switch expr := unparen(expr).(type) {
+	case *ast.Ident:
+		return synced[pass.TypesInfo.ObjectOf(expr)]
+	case *ast.CallExpr:
+		fn := assertionFunc(pass, expr)
+		if fn == nil || fn.Pkg().Path() != requirePath {
+			return false
+		}
+		if fn.Name() == "Sync" {
+			return true
+		}
+		if fn.Type().(*types.Signature).Recv() != nil {
+			// methods returning Assertions derived from a synced receiver,
+			// such as r.Sync().With("id=%d", id)
+			sel, ok := unparen(expr.Fun).(*ast.SelectorExpr)
+			return ok && synced.is(pass, sel.X)
+		}
+		// New, WithSpan and the like, given a synced TestingT
+		return len(expr.Args) > 0 && synced.is(pass, expr.Args[0])
+	}
+	return false
+}
+
+// assertionFunc returns the demand assertion function or method called
+// by call, or nil.
+func assertionFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	var ident *ast.Ident
+	switch fun := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.IndexExpr:
+		// explicit instantiation of generic function
+		return assertionFunc(pass, &ast.CallExpr{Fun: fun.X})
+	default:
+		return nil
+	}
+	fn, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return nil
+	}
+	switch fn.Pkg().Path() {
+	case requirePath, checkPath:
+		return fn
+	}
+	return nil
+}
+
+// paramArg returns the argument of call passed for the parameter with
+// the given name, or nil.
+func paramArg(fn *types.Func, call *ast.CallExpr, name string) ast.Expr {
+	params := fn.Type().(*types.Signature).Params()
+	for i := 0; i < params.Len(); i++ {
+		if params.At(i).Name() == name {
+			if i < len(call.Args) {
+				return call.Args[i]
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func checkCall(pass *analysis.Pass, call *ast.CallExpr) {
+	fn := assertionFunc(pass, call)
+	if fn == nil {
+		return
+	}
+	expected := paramArg(fn, call, "expected")
+	actual := paramArg(fn, call, "actual")
+	if expected == nil || actual == nil {
+		return
+	}
+
+	if isConstant(pass, actual) && !isConstant(pass, expected) {
+		pass.Reportf(
+			call.Pos(),
+			"%s: constant %s is passed as actual value, expected and actual arguments may be swapped",
+			fn.Name(), types.ExprString(actual),
+		)
+	}
+
+	if equalityFuncs[fn.Name()] && isError(pass, expected) && isError(pass, actual) {
+		pass.Reportf(
+			call.Pos(),
+			"%s: comparing errors for equality, use ErrorIs to match wrapped errors",
+			fn.Name(),
+		)
+	}
+
+	if types.ExprString(expected) == types.ExprString(actual) && !hasCall(expected) {
+		pass.Reportf(
+			call.Pos(),
+			"%s: useless assertion, comparing %s with itself",
+			fn.Name(), types.ExprString(actual),
+		)
+	}
+}
+
+// checkGoStmt reports require calls in the function literal started by
+// a go statement, which can't stop the test from that goroutine, unless
+// they are made with a TestingT or Assertions made by Sync.
+func checkGoStmt(pass *analysis.Pass, stmt *ast.GoStmt, synced syncedVars) {
+	lit, ok := stmt.Call.Fun.(*ast.FuncLit)
+	if !ok {
+		return
+	}
+	ast.Inspect(lit.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			if len(node.Lhs) == len(node.Rhs) {
+				for i, lhs := range node.Lhs {
+					synced.assign(pass, lhs, node.Rhs[i])
+				}
+			}
+			return true
+		case *ast.CallExpr:
+			fn := assertionFunc(pass, node)
+			if fn == nil || fn.Pkg().Path() != requirePath || synced.is(pass, node) {
+				return true
+			}
+			if fn.Type().(*types.Signature).Recv() != nil {
+				if sel, ok := unparen(node.Fun).(*ast.SelectorExpr); ok && synced.is(pass, sel.X) {
+					return true
+				}
+			} else if len(node.Args) > 0 && synced.is(pass, node.Args[0]) {
+				return true
+			}
+			pass.Reportf(
+				node.Pos(),
+				"%s: require call in a goroutine can not stop the test, use check package, require.Sync or report back to the test goroutine",
+				fn.Name(),
+			)
+		}
+		return true
+	})
+}
+
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.X
+	}
+}
+
+func isConstant(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok {
+		return false
+	}
+	return tv.Value != nil
+}
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// isError reports if expr is a non-nil value implementing error.
+func isError(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.IsNil() || tv.Type == nil {
+		return false
+	}
+	return types.Implements(tv.Type, errorType)
+}
+
+// hasCall reports if expr contains a function call, in which case
+// comparing it with itself is not necessarily useless.
+func hasCall(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if _, ok := node.(*ast.CallExpr); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,108 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package cases runs table-driven tests, with a subtest for each case.
+package cases
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/ilius/demand/require"
+)
+
+type caseOptions struct {
+	parallel bool
+}
+
+// Option customizes how Run runs the cases.
+type Option func(*caseOptions)
+
+// Parallel makes Run run the cases as parallel subtests, by calling
+// t.Parallel() at the start of each subtest.
+func Parallel() Option {
+	return func(o *caseOptions) {
+		o.parallel = true
+	}
+}
+
+// Run runs f for each case in a subtest of t.
+//
+// The subtest is named after the Name field of the case, if the case is
+// a struct (or pointer to struct) with a non-empty string field called Name,
+// and after its index in cases otherwise.
+//
+//	type addCase struct {
+//		Name string
+//		A, B int
+//		Sum  int
+//	}
+//
+//	cases.Run(t, []addCase{
+//		{Name: "zero", A: 0, B: 0, Sum: 0},
+//		{Name: "positive", A: 1, B: 2, Sum: 3},
+//	}, func(t *testing.T, c addCase) {
+//		require.Equal(t, c.Sum, c.A+c.B)
+//	})
+func Run[C any](t *testing.T, cases []C, f func(t *testing.T, c C), options ...Option) {
+	t.Helper()
+	opts := &caseOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	for i, c := range cases {
+		c := c
+		t.Run(caseName(c, i), func(t *testing.T) {
+			if opts.parallel {
+				t.Parallel()
+			}
+			f(t, c)
+		})
+	}
+}
+
+// RunRequire is like Run, but f receives an Assertions instance created
+// for the subtest of each case instead of its *testing.T.
+func RunRequire[C any](t *testing.T, cases []C, f func(r *require.Assertions, c C), options ...Option) {
+	t.Helper()
+	Run(t, cases, func(t *testing.T, c C) {
+		f(require.New(t), c)
+	}, options...)
+}
+
+// caseName returns the value of the Name field of c, or the index if c
+// has no such field or it is empty.
+func caseName(c any, index int) string {
+	value := reflect.ValueOf(c)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct {
+		field := value.FieldByName("Name")
+		if field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			return field.String()
+		}
+	}
+	return fmt.Sprintf("case_%d", index)
+}
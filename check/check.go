@@ -0,0 +1,129 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package check provides the assertions of the require package as
+// functions that return an error describing the mismatch (or nil on
+// success) instead of failing a test.
+//
+// It is meant for non-test code such as health checks and input
+// validators, and for conditions that want to aggregate failure reasons:
+//
+//	if err := check.Equal(http.StatusOK, resp.StatusCode); err != nil {
+//		reasons = append(reasons, err)
+//	}
+//
+// The package links the testing package, since the assertions take a
+// testing.TB, but it registers no command-line flags outside test
+// binaries, and it does not link net/http/httptest.
+package check
+
+//go:generate go run ../internal/gen check
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ilius/demand/require"
+)
+
+// errFailNow is used for stopping an assertion on FailNow, and recovered
+// by capture.
+var errFailNow = errors.New("check: FailNow")
+
+// recorder is a testing.TB that records the failures of assertions.
+// Methods of testing.TB that are not overridden must not be used by
+// assertions, since the embedded testing.TB is nil.
+type recorder struct {
+	testing.TB
+	failed   bool
+	messages []string
+}
+
+func (r *recorder) Helper() {}
+
+// RecordsFailures implements core.Recorder.
+func (r *recorder) RecordsFailures() {}
+
+func (r *recorder) Name() string {
+	return "check"
+}
+
+func (r *recorder) Log(args ...any) {}
+
+func (r *recorder) Logf(format string, args ...any) {}
+
+func (r *recorder) Fail() {
+	r.failed = true
+}
+
+func (r *recorder) Failed() bool {
+	return r.failed
+}
+
+func (r *recorder) FailNow() {
+	r.failed = true
+	panic(errFailNow)
+}
+
+func (r *recorder) Error(args ...any) {
+	r.messages = append(r.messages, fmt.Sprint(args...))
+	r.Fail()
+}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+	r.Fail()
+}
+
+func (r *recorder) Fatal(args ...any) {
+	r.messages = append(r.messages, fmt.Sprint(args...))
+	r.FailNow()
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.messages = append(r.messages, fmt.Sprintf(format, args...))
+	r.FailNow()
+}
+
+// capture runs the assertion with a recorder, and returns the recorded
+// failures as an error.
+func capture(assertion func(t require.TestingT)) error {
+	r := &recorder{}
+	func() {
+		defer func() {
+			if p := recover(); p != nil && p != errFailNow {
+				panic(p)
+			}
+		}()
+		assertion(r)
+	}()
+	if !r.failed {
+		return nil
+	}
+	if len(r.messages) == 0 {
+		return errors.New("check failed")
+	}
+	return errors.New(strings.Join(r.messages, "\n"))
+}
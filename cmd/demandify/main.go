@@ -0,0 +1,122 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command demandify migrates Go code from github.com/stretchr/testify to
+// github.com/ilius/demand, by rewriting imports and call sites of all Go
+// files in the given directories (recursively).
+//
+// Usage:
+//
+//	demandify [-n] [dir ...]
+//
+// With -n (dry-run), the changes are printed as a unified diff instead of
+// being written to the files.
+//
+// Packages are migrated as follows:
+//
+//   - testify/require, testify/suite and testify/mock are replaced with
+//     demand's require, suite and mock packages, and calls with different
+//     signatures are fixed (for example, IsType takes a reflect.Type).
+//   - testify/assert is replaced with demand's testify/assert, which keeps
+//     the non-fatal, bool-returning API of testify.
+//
+// Code that still doesn't compile after migration (for example Greater
+// with arguments of different types, which is generic in demand) is left
+// for the user to fix.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ilius/demand/internal/diff"
+)
+
+func main() {
+	dryRun := flag.Bool("n", false, "dry-run: print the changes as a diff, don't write files")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: demandify [-n] [dir ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	failed := false
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				name := d.Name()
+				if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") {
+				return nil
+			}
+			if err := processFile(path, *dryRun); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func processFile(path string, dryRun bool) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	result, changed, err := rewrite(path, src)
+	if err != nil || !changed {
+		return err
+	}
+	if dryRun {
+		fmt.Print(diff.Unified(path, path, string(src), string(result)))
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, result, info.Mode().Perm())
+}
@@ -0,0 +1,207 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+)
+
+const (
+	testifyPrefix = "github.com/stretchr/testify/"
+	demandPrefix  = "github.com/ilius/demand/"
+)
+
+// importReplacements maps testify packages to demand packages.
+var importReplacements = map[string]string{
+	testifyPrefix + "require": demandPrefix + "require",
+	testifyPrefix + "suite":   demandPrefix + "suite",
+	testifyPrefix + "mock":    demandPrefix + "mock",
+	testifyPrefix + "assert":  demandPrefix + "testify/assert",
+}
+
+// rewrite migrates the source of a Go file, and reports if it was changed.
+func rewrite(filename string, src []byte) ([]byte, bool, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed := false
+	// local names of the imported testify/require packages
+	requireNames := map[string]bool{}
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		newPath, ok := importReplacements[importPath]
+		if !ok {
+			continue
+		}
+		if importPath == testifyPrefix+"require" {
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			requireNames[name] = true
+		}
+		spec.Path.Value = strconv.Quote(newPath)
+		changed = true
+	}
+	if !changed {
+		return src, false, nil
+	}
+
+	if fixCalls(file, requireNames) {
+		addImport(file, "reflect")
+	}
+	ast.SortImports(fset, file)
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), true, nil
+}
+
+// fixCalls fixes calls of require functions and *require.Assertions methods
+// whose signature is different in demand, and reports if reflect package
+// is needed for the fixes.
+func fixCalls(file *ast.File, requireNames map[string]bool) bool {
+	// variables holding *require.Assertions, assigned from require.New(t)
+	assertionsVars := map[string]bool{}
+	ast.Inspect(file, func(node ast.Node) bool {
+		assign, ok := node.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != len(assign.Rhs) {
+			return true
+		}
+		for i, rhs := range assign.Rhs {
+			ident, ok := assign.Lhs[i].(*ast.Ident)
+			if ok && isRequireNew(rhs, requireNames) {
+				assertionsVars[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	isReceiver := func(expr ast.Expr) bool {
+		switch expr := expr.(type) {
+		case *ast.Ident:
+			return requireNames[expr.Name] || assertionsVars[expr.Name]
+		case *ast.CallExpr:
+			// suite.Require()
+			sel, ok := expr.Fun.(*ast.SelectorExpr)
+			if ok && sel.Sel.Name == "Require" && len(expr.Args) == 0 {
+				return true
+			}
+			return isRequireNew(expr, requireNames)
+		}
+		return false
+	}
+
+	needReflect := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !isReceiver(sel.X) {
+			return true
+		}
+		// functions take t as the first argument, methods don't
+		typeArg := 0
+		if ident, ok := sel.X.(*ast.Ident); ok && requireNames[ident.Name] {
+			typeArg = 1
+		}
+		switch sel.Sel.Name {
+		case "IsType", "IsTypef":
+			// testify takes an object of the expected type,
+			// demand takes the reflect.Type
+			if len(call.Args) > typeArg+1 {
+				call.Args[typeArg] = &ast.CallExpr{
+					Fun: &ast.SelectorExpr{
+						X:   ast.NewIdent("reflect"),
+						Sel: ast.NewIdent("TypeOf"),
+					},
+					Args: []ast.Expr{call.Args[typeArg]},
+				}
+				needReflect = true
+			}
+		}
+		return true
+	})
+	return needReflect
+}
+
+// isRequireNew reports if expr is a require.New(t) call.
+func isRequireNew(expr ast.Expr, requireNames map[string]bool) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "New" {
+		return false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && requireNames[pkg.Name]
+}
+
+// addImport adds an import of the standard package with the given path,
+// unless it is already imported.
+func addImport(file *ast.File, importPath string) {
+	quoted := strconv.Quote(importPath)
+	for _, spec := range file.Imports {
+		if spec.Path.Value == quoted {
+			return
+		}
+	}
+	spec := &ast.ImportSpec{
+		Path: &ast.BasicLit{Kind: token.STRING, Value: quoted},
+	}
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		if !genDecl.Lparen.IsValid() {
+			genDecl.Lparen = genDecl.Pos()
+			genDecl.Rparen = genDecl.End()
+		}
+		// place it first, so that it is sorted with the other standard packages
+		first := genDecl.Specs[0].(*ast.ImportSpec)
+		spec.Path.ValuePos = first.Pos()
+		genDecl.Specs = append([]ast.Spec{spec}, genDecl.Specs...)
+		file.Imports = append(file.Imports, spec)
+		return
+	}
+}
@@ -0,0 +1,70 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package contract provides design-by-contract checks for library code:
+// preconditions (Require), postconditions (Ensure) and invariants
+// (Invariant), which panic with a *Violation when the condition is false.
+//
+// Building with the contracts_off build tag turns all checks into no-ops:
+//
+//	go build -tags contracts_off ./...
+//
+// Arguments are still evaluated when checks are disabled, so expensive
+// conditions should be guarded by the Enabled constant, which the compiler
+// eliminates along with the check:
+//
+//	if contract.Enabled {
+//		contract.Invariant(tree.isBalanced(), "tree is not balanced")
+//	}
+package contract
+
+import "github.com/ilius/demand/internal/core"
+
+// Kind is the kind of a contract: precondition, postcondition or invariant.
+type Kind string
+
+const (
+	Precondition  Kind = "precondition"
+	Postcondition Kind = "postcondition"
+	InvariantKind Kind = "invariant"
+)
+
+// Violation is the panic value of a failed contract check.
+type Violation struct {
+	Kind    Kind
+	Message string
+}
+
+func (v *Violation) Error() string {
+	if v.Message == "" {
+		return string(v.Kind) + " violated"
+	}
+	return string(v.Kind) + " violated: " + v.Message
+}
+
+func violated(kind Kind, msgAndArgs []any) {
+	panic(&Violation{
+		Kind:    kind,
+		Message: core.FormatMsgAndArgs(msgAndArgs),
+	})
+}
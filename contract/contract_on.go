@@ -0,0 +1,54 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build !contracts_off
+
+package contract
+
+// Enabled is true if contract checks are enabled, which is the case unless
+// built with the contracts_off build tag.
+const Enabled = true
+
+// Require checks a precondition, typically of the arguments of a function,
+// and panics with a *Violation if it is false.
+func Require(condition bool, msgAndArgs ...any) {
+	if !condition {
+		violated(Precondition, msgAndArgs)
+	}
+}
+
+// Ensure checks a postcondition, typically of the results of a function,
+// and panics with a *Violation if it is false.
+func Ensure(condition bool, msgAndArgs ...any) {
+	if !condition {
+		violated(Postcondition, msgAndArgs)
+	}
+}
+
+// Invariant checks an invariant, typically of the state of an object,
+// and panics with a *Violation if it is false.
+func Invariant(condition bool, msgAndArgs ...any) {
+	if !condition {
+		violated(InvariantKind, msgAndArgs)
+	}
+}
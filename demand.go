@@ -0,0 +1,152 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package demand
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ilius/demand/internal/core"
+)
+
+// Violation is the error describing a failed invariant.
+// The default violation handler panics with a *Violation.
+type Violation struct {
+	Message string
+}
+
+func (v *Violation) Error() string {
+	return "demand: " + v.Message
+}
+
+// ViolationHandler is called when an invariant is violated.
+type ViolationHandler func(v *Violation)
+
+// PanicHandler is the default violation handler, which panics with v.
+func PanicHandler(v *Violation) {
+	panic(v)
+}
+
+// Demand checks invariants (preconditions, postconditions, etc) in
+// non-test code. Unlike require functions, a violation does not fail a test,
+// but calls the violation handler, which panics by default.
+type Demand struct {
+	mutex   sync.RWMutex
+	handler ViolationHandler
+}
+
+// New creates a Demand with the given violation handler.
+// A nil handler means PanicHandler.
+func New(handler ViolationHandler) *Demand {
+	return &Demand{handler: handler}
+}
+
+// SetViolationHandler changes the violation handler.
+// A nil handler means PanicHandler.
+func (d *Demand) SetViolationHandler(handler ViolationHandler) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.handler = handler
+}
+
+func (d *Demand) violated(msg string, msgAndArgs []any) {
+	if extra := core.FormatMsgAndArgs(msgAndArgs); extra != "" {
+		msg += " - " + extra
+	}
+	d.mutex.RLock()
+	handler := d.handler
+	d.mutex.RUnlock()
+	if handler == nil {
+		handler = PanicHandler
+	}
+	handler(&Violation{Message: msg})
+}
+
+// True demands that the condition is true.
+func (d *Demand) True(condition bool, msgAndArgs ...any) {
+	if !condition {
+		d.violated("expected condition to be true", msgAndArgs)
+	}
+}
+
+// False demands that the condition is false.
+func (d *Demand) False(condition bool, msgAndArgs ...any) {
+	if condition {
+		d.violated("expected condition to be false", msgAndArgs)
+	}
+}
+
+// Nil demands that the object is nil.
+func (d *Demand) Nil(object any, msgAndArgs ...any) {
+	if !core.IsNil(object) {
+		d.violated(fmt.Sprintf("expected %T to be nil, but got: %v", object, object), msgAndArgs)
+	}
+}
+
+// NotNil demands that the object is not nil.
+func (d *Demand) NotNil(object any, msgAndArgs ...any) {
+	if core.IsNil(object) {
+		d.violated(fmt.Sprintf("expected %T not to be nil", object), msgAndArgs)
+	}
+}
+
+// NoError demands that err is nil.
+func (d *Demand) NoError(err error, msgAndArgs ...any) {
+	if err != nil {
+		d.violated(fmt.Sprintf("expected no error, but got: %v", err), msgAndArgs)
+	}
+}
+
+// Default is the Demand used by the package-level functions.
+var Default = New(nil)
+
+// SetViolationHandler changes the violation handler of Default.
+func SetViolationHandler(handler ViolationHandler) {
+	Default.SetViolationHandler(handler)
+}
+
+// True demands that the condition is true, using Default.
+func True(condition bool, msgAndArgs ...any) {
+	Default.True(condition, msgAndArgs...)
+}
+
+// False demands that the condition is false, using Default.
+func False(condition bool, msgAndArgs ...any) {
+	Default.False(condition, msgAndArgs...)
+}
+
+// Nil demands that the object is nil, using Default.
+func Nil(object any, msgAndArgs ...any) {
+	Default.Nil(object, msgAndArgs...)
+}
+
+// NotNil demands that the object is not nil, using Default.
+func NotNil(object any, msgAndArgs ...any) {
+	Default.NotNil(object, msgAndArgs...)
+}
+
+// NoError demands that err is nil, using Default.
+func NoError(err error, msgAndArgs ...any) {
+	Default.NoError(err, msgAndArgs...)
+}
@@ -1,5 +1,18 @@
 module github.com/ilius/demand
 
-go 1.20
+go 1.21
 
-require github.com/ilius/is/v2 v2.4.0
+require (
+	github.com/andybalholm/brotli v1.1.0
+	golang.org/x/tools v0.24.0
+	google.golang.org/grpc v1.64.1
+	google.golang.org/protobuf v1.33.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/net v0.28.0 // indirect
+	golang.org/x/sys v0.23.0 // indirect
+	golang.org/x/text v0.17.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
+)
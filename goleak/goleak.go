@@ -0,0 +1,112 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package goleak detects goroutines that are still running after a test
+// (or a whole test binary) has finished.
+package goleak
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ilius/demand/require"
+)
+
+// TestingM is the subset of *testing.M used by VerifyTestMain.
+type TestingM interface {
+	Run() int
+}
+
+// Find looks for extra goroutines, and returns a descriptive error if
+// any are found (after retrying for a while to let them exit).
+func Find(options ...Option) error {
+	opts := buildOpts(options...)
+	cur := currentID()
+	var leaked []stack
+	for attempt := 0; ; attempt++ {
+		leaked = filterStacks(allStacks(), cur, opts)
+		if len(leaked) == 0 {
+			return nil
+		}
+		if attempt >= opts.maxRetries {
+			break
+		}
+		time.Sleep(backoff(attempt, opts.maxSleep))
+	}
+	parts := make([]string, len(leaked))
+	for i, s := range leaked {
+		parts[i] = s.full
+	}
+	return fmt.Errorf("found %d unexpected goroutines:\n%s", len(leaked), strings.Join(parts, "\n\n"))
+}
+
+// VerifyNone fails the test if there are goroutines running other than
+// the ones ignored by the given options.
+// It is usually called at the end of a test, often with defer.
+func VerifyNone(t require.TestingT, options ...Option) {
+	t.Helper()
+	if err := Find(options...); err != nil {
+		require.Fail(t, err.Error())
+	}
+}
+
+// VerifyTestMain runs all tests using m.Run(), then checks for leaked
+// goroutines. It should be called from TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		goleak.VerifyTestMain(m)
+//	}
+//
+// If tests pass but goroutines were leaked, the leaked goroutines are
+// printed to stderr and the process exits with a non-zero code.
+func VerifyTestMain(m TestingM, options ...Option) {
+	exitCode := m.Run()
+	if exitCode == 0 {
+		if err := Find(options...); err != nil {
+			fmt.Fprintf(os.Stderr, "goleak: errors on successful test run: %v\n", err)
+			exitCode = 1
+		}
+	}
+	os.Exit(exitCode)
+}
+
+func backoff(attempt int, maxSleep time.Duration) time.Duration {
+	d := time.Microsecond << uint(attempt)
+	if d <= 0 || d > maxSleep {
+		return maxSleep
+	}
+	return d
+}
+
+func currentID() int {
+	buf := make([]byte, 64)
+	n := runtime.Stack(buf, false)
+	s, ok := parseStack(string(buf[:n]))
+	if !ok {
+		return -1
+	}
+	return s.id
+}
@@ -0,0 +1,130 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package goleak
+
+import (
+	"strings"
+	"time"
+)
+
+type opts struct {
+	filters    []func(stack) bool
+	maxRetries int
+	maxSleep   time.Duration
+}
+
+// Option lets users customize which goroutines are ignored and how long
+// Find waits for goroutines to exit.
+type Option func(*opts)
+
+func buildOpts(options ...Option) *opts {
+	o := &opts{
+		maxRetries: 20,
+		maxSleep:   100 * time.Millisecond,
+	}
+	for _, option := range options {
+		option(o)
+	}
+	o.filters = append(o.filters, isTestStack, isSyscallStack, isStdLibStack)
+	return o
+}
+
+func (o *opts) ignored(s stack) bool {
+	for _, filter := range o.filters {
+		if filter(s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IgnoreTopFunction ignores goroutines whose topmost function (the one
+// currently running) is f, for example "net/http.(*persistConn).readLoop".
+func IgnoreTopFunction(f string) Option {
+	return func(o *opts) {
+		o.filters = append(o.filters, func(s stack) bool {
+			return s.topFunc == f
+		})
+	}
+}
+
+// IgnoreAnyFunction ignores goroutines that have f anywhere in their stack.
+func IgnoreAnyFunction(f string) Option {
+	return func(o *opts) {
+		o.filters = append(o.filters, func(s stack) bool {
+			return s.hasFunc(f)
+		})
+	}
+}
+
+// IgnoreCurrent ignores all goroutines that are running at the time this
+// option is created, typically background goroutines started by libraries
+// before the test begins.
+func IgnoreCurrent() Option {
+	ids := map[int]bool{}
+	for _, s := range allStacks() {
+		ids[s.id] = true
+	}
+	return func(o *opts) {
+		o.filters = append(o.filters, func(s stack) bool {
+			return ids[s.id]
+		})
+	}
+}
+
+// MaxRetries sets the number of times Find re-checks the running
+// goroutines before reporting a leak. Default is 20.
+func MaxRetries(n int) Option {
+	return func(o *opts) {
+		o.maxRetries = n
+	}
+}
+
+// MaxSleep sets the maximum time to sleep between retries. Default is 100ms.
+func MaxSleep(d time.Duration) Option {
+	return func(o *opts) {
+		o.maxSleep = d
+	}
+}
+
+// isTestStack ignores the goroutines of the testing package that are
+// waiting for tests (or parallel subtests) to finish.
+func isTestStack(s stack) bool {
+	switch s.topFunc {
+	case "testing.RunTests", "testing.(*T).Run", "testing.(*T).Parallel":
+		return s.state == "chan receive"
+	}
+	return false
+}
+
+// isSyscallStack ignores the goroutine handling os/signal notifications.
+func isSyscallStack(s stack) bool {
+	return s.hasFunc("os/signal.signal_recv") || s.hasFunc("os/signal.loop")
+}
+
+// isStdLibStack ignores goroutines started by the runtime tracer.
+func isStdLibStack(s stack) bool {
+	return strings.HasPrefix(s.firstFunc, "runtime/trace.Start") ||
+		s.topFunc == "runtime.ReadTrace"
+}
@@ -0,0 +1,134 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package goleak
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// stack is a parsed goroutine stack trace as printed by runtime.Stack.
+type stack struct {
+	id    int
+	state string
+	// topFunc is the function currently running in the goroutine
+	topFunc string
+	// firstFunc is the function the goroutine was started with
+	firstFunc string
+	funcs     []string
+	full      string
+}
+
+func (s stack) hasFunc(f string) bool {
+	for _, fn := range s.funcs {
+		if fn == f {
+			return true
+		}
+	}
+	return false
+}
+
+func allStacks() []stack {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+	var stacks []stack
+	for _, part := range strings.Split(string(buf), "\n\n") {
+		if s, ok := parseStack(part); ok {
+			stacks = append(stacks, s)
+		}
+	}
+	return stacks
+}
+
+func filterStacks(stacks []stack, skipID int, o *opts) []stack {
+	var result []stack
+	for _, s := range stacks {
+		if s.id == skipID || o.ignored(s) {
+			continue
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+// parseStack parses a single goroutine stack, which looks like:
+//
+//	goroutine 18 [chan receive]:
+//	main.worker(...)
+//		/path/to/main.go:12 +0x25
+//	created by main.main in goroutine 1
+//		/path/to/main.go:8 +0x3e
+func parseStack(text string) (stack, bool) {
+	text = strings.TrimSpace(text)
+	lines := strings.Split(text, "\n")
+	header := lines[0]
+	if !strings.HasPrefix(header, "goroutine ") || !strings.HasSuffix(header, ":") {
+		return stack{}, false
+	}
+	header = strings.TrimSuffix(strings.TrimPrefix(header, "goroutine "), ":")
+	idStr, state, ok := strings.Cut(header, " ")
+	if !ok {
+		return stack{}, false
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return stack{}, false
+	}
+	state = strings.TrimSuffix(strings.TrimPrefix(state, "["), "]")
+	// state may have a suffix such as ", 5 minutes" or ", locked to thread"
+	state, _, _ = strings.Cut(state, ",")
+	s := stack{
+		id:    id,
+		state: state,
+		full:  text,
+	}
+	for _, line := range lines[1:] {
+		if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "created by ") {
+			continue
+		}
+		s.funcs = append(s.funcs, funcName(line))
+	}
+	if len(s.funcs) > 0 {
+		s.topFunc = s.funcs[0]
+		s.firstFunc = s.funcs[len(s.funcs)-1]
+	}
+	return s, true
+}
+
+// funcName strips the arguments from a function line of a stack trace,
+// for example "main.worker(0xc000012345, ...)" becomes "main.worker".
+func funcName(line string) string {
+	if i := strings.LastIndex(line, "("); i > 0 && strings.HasSuffix(line, ")") {
+		return line[:i]
+	}
+	return line
+}
@@ -0,0 +1,137 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package grpcassert provides assertions on gRPC status errors, which find
+// the *status.Status of an error (including wrapped errors), so that tests
+// of gRPC services don't need to repeat status.FromError plumbing:
+//
+//	_, err := client.GetUser(ctx, req)
+//	grpcassert.StatusCode(t, err, codes.NotFound)
+//	grpcassert.StatusMessageContains(t, err, "user 42")
+package grpcassert
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ilius/demand/require"
+)
+
+// grpcStatus is implemented by the errors of gRPC status package.
+type grpcStatus interface {
+	GRPCStatus() *status.Status
+}
+
+// fromError returns the status of the first error in err's chain that has
+// a status, or false if there is none.
+// A nil error has status OK.
+func fromError(err error) (*status.Status, bool) {
+	if err == nil {
+		return status.New(codes.OK, ""), true
+	}
+	var se grpcStatus
+	if !errors.As(err, &se) {
+		return nil, false
+	}
+	return se.GRPCStatus(), true
+}
+
+// statusOf returns the status of err, failing if err has no status.
+func statusOf(t require.TestingT, err error, msgAndArgs []any) (*status.Status, bool) {
+	st, ok := fromError(err)
+	if !ok {
+		require.Fail(t, fmt.Sprintf("expected a gRPC status error, but got: %v (%T)", err, err), msgAndArgs...)
+		return nil, false
+	}
+	return st, true
+}
+
+// StatusCode asserts that err has a gRPC status with the given code.
+// A nil error has code OK.
+func StatusCode(t require.TestingT, err error, code codes.Code, msgAndArgs ...any) {
+	t.Helper()
+	st, ok := statusOf(t, err, msgAndArgs)
+	if !ok {
+		return
+	}
+	if st.Code() != code {
+		require.Fail(t, fmt.Sprintf(
+			"gRPC status code mismatch:\nexpected: %s\nactual  : %s\nmessage : %q",
+			code, st.Code(), st.Message(),
+		), msgAndArgs...)
+	}
+}
+
+// StatusMessageContains asserts that err has a gRPC status whose message
+// contains substr.
+func StatusMessageContains(t require.TestingT, err error, substr string, msgAndArgs ...any) {
+	t.Helper()
+	st, ok := statusOf(t, err, msgAndArgs)
+	if !ok {
+		return
+	}
+	if !strings.Contains(st.Message(), substr) {
+		require.Fail(t, fmt.Sprintf(
+			"gRPC status message %q (code %s) does not contain %q",
+			st.Message(), st.Code(), substr,
+		), msgAndArgs...)
+	}
+}
+
+// ErrorDetailsContain asserts that err has a gRPC status whose details
+// include a message equal to detail (see proto.Equal).
+func ErrorDetailsContain(t require.TestingT, err error, detail proto.Message, msgAndArgs ...any) {
+	t.Helper()
+	st, ok := statusOf(t, err, msgAndArgs)
+	if !ok {
+		return
+	}
+	details := st.Proto().GetDetails()
+	var found []string
+	for _, anyDetail := range details {
+		msg, err := anyDetail.UnmarshalNew()
+		if err != nil {
+			found = append(found, fmt.Sprintf("%s (%v)", anyDetail.GetTypeUrl(), err))
+			continue
+		}
+		if proto.Equal(msg, detail) {
+			return
+		}
+		found = append(found, fmt.Sprintf("%s: {%s}", msg.ProtoReflect().Descriptor().FullName(), formatProto(msg)))
+	}
+	require.Fail(t, fmt.Sprintf(
+		"gRPC status details do not contain %s: {%s}\ndetails: %v",
+		detail.ProtoReflect().Descriptor().FullName(), formatProto(detail), found,
+	), msgAndArgs...)
+}
+
+// formatProto formats a proto message in single-line text format.
+func formatProto(msg proto.Message) string {
+	return prototext.MarshalOptions{}.Format(msg)
+}
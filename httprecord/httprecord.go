@@ -0,0 +1,236 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package httprecord provides an http.RoundTripper that records the
+// outgoing requests of code under test, with assertions on the recorded
+// requests, for testing HTTP clients (rather than handlers):
+//
+//	rec := httprecord.New(httprecord.Respond(http.StatusOK, `{"id": 1}`))
+//	client := NewAPIClient(rec.Client())
+//	client.CreateUser("alice")
+//	rec.RequestCount(t, 1)
+//	rec.RequestedURL(t, "POST", "https://api.example.com/users")
+//	rec.RequestBodyJSONEq(t, 0, `{"name": "alice"}`)
+package httprecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/ilius/demand/require"
+)
+
+// Request is a recorded request.
+type Request struct {
+	Method string
+	URL    string
+	Header http.Header
+	Body   []byte
+}
+
+// String returns the method and URL of the request.
+func (r *Request) String() string {
+	return r.Method + " " + r.URL
+}
+
+// Recorder is an http.RoundTripper that records requests, and passes them
+// to the next http.RoundTripper. It is safe for concurrent use.
+type Recorder struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	requests []*Request
+}
+
+// New returns a Recorder passing requests to next, which can be a real
+// transport (such as http.DefaultTransport for a test server), or a fake
+// one such as Respond or Handler.
+func New(next http.RoundTripper) *Recorder {
+	return &Recorder{next: next}
+}
+
+// RoundTrip records the request and passes it to the next RoundTripper.
+func (rec *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := &Request{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header.Clone(),
+	}
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		recorded.Body = body
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	rec.mu.Lock()
+	rec.requests = append(rec.requests, recorded)
+	rec.mu.Unlock()
+	return rec.next.RoundTrip(req)
+}
+
+// Client returns an http.Client using the Recorder as its transport.
+func (rec *Recorder) Client() *http.Client {
+	return &http.Client{Transport: rec}
+}
+
+// Requests returns the recorded requests.
+func (rec *Recorder) Requests() []*Request {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	return append([]*Request{}, rec.requests...)
+}
+
+// Reset forgets the recorded requests.
+func (rec *Recorder) Reset() {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	rec.requests = nil
+}
+
+// RoundTripperFunc is an adapter to use a function as http.RoundTripper.
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip calls f(req).
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Handler returns an http.RoundTripper that serves requests with handler,
+// in process.
+func Handler(handler http.Handler) http.RoundTripper {
+	return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Result(), nil
+	})
+}
+
+// Respond returns an http.RoundTripper that responds to all requests with
+// the given status code and body.
+func Respond(code int, body string) http.RoundTripper {
+	return Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(code)
+		_, _ = io.WriteString(w, body)
+	}))
+}
+
+// formatRequests lists the requests for failure messages.
+func formatRequests(requests []*Request) string {
+	if len(requests) == 0 {
+		return "no requests"
+	}
+	lines := make([]string, len(requests))
+	for i, req := range requests {
+		lines[i] = fmt.Sprintf("\t%d: %s", i, req)
+	}
+	return "requests:\n" + strings.Join(lines, "\n")
+}
+
+// request returns the request with index i (negative i counts from the
+// end, so -1 is the last request), failing if there is no such request.
+func (rec *Recorder) request(t require.TestingT, i int, msgAndArgs []any) (*Request, bool) {
+	requests := rec.Requests()
+	index := i
+	if index < 0 {
+		index += len(requests)
+	}
+	if index < 0 || index >= len(requests) {
+		require.Fail(t, fmt.Sprintf("httprecord: no request with index %d, %s", i, formatRequests(requests)), msgAndArgs...)
+		return nil, false
+	}
+	return requests[index], true
+}
+
+// RequestCount asserts that n requests were recorded.
+func (rec *Recorder) RequestCount(t require.TestingT, n int, msgAndArgs ...any) {
+	t.Helper()
+	requests := rec.Requests()
+	if len(requests) != n {
+		require.Fail(t, fmt.Sprintf("httprecord: expected %d requests, but got %d, %s", n, len(requests), formatRequests(requests)), msgAndArgs...)
+	}
+}
+
+// RequestedURL asserts that a request with the given method and URL was
+// recorded.
+func (rec *Recorder) RequestedURL(t require.TestingT, method string, url string, msgAndArgs ...any) {
+	t.Helper()
+	requests := rec.Requests()
+	for _, req := range requests {
+		if req.Method == method && req.URL == url {
+			return
+		}
+	}
+	require.Fail(t, fmt.Sprintf("httprecord: expected a request %s %s, %s", method, url, formatRequests(requests)), msgAndArgs...)
+}
+
+// RequestHeaderEqual asserts that the request with index i (negative i
+// counts from the end) has the header key with the given value.
+func (rec *Recorder) RequestHeaderEqual(t require.TestingT, i int, key string, value string, msgAndArgs ...any) {
+	t.Helper()
+	req, ok := rec.request(t, i, msgAndArgs)
+	if !ok {
+		return
+	}
+	values, present := req.Header[http.CanonicalHeaderKey(key)]
+	if !present {
+		require.Fail(t, fmt.Sprintf("httprecord: request %s has no header %q", req, key), msgAndArgs...)
+		return
+	}
+	if len(values) != 1 || values[0] != value {
+		require.Fail(t, fmt.Sprintf("httprecord: request %s header %q mismatch:\nexpected: %q\nactual  : %q", req, key, value, values), msgAndArgs...)
+	}
+}
+
+// RequestBodyJSONEq asserts that the body of the request with index i
+// (negative i counts from the end) is JSON equivalent to expected,
+// ignoring formatting and order of object keys.
+func (rec *Recorder) RequestBodyJSONEq(t require.TestingT, i int, expected string, msgAndArgs ...any) {
+	t.Helper()
+	req, ok := rec.request(t, i, msgAndArgs)
+	if !ok {
+		return
+	}
+	var expectedValue, actualValue any
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		require.Fail(t, fmt.Sprintf("httprecord: expected value is not valid JSON: %v", err), msgAndArgs...)
+		return
+	}
+	if err := json.Unmarshal(req.Body, &actualValue); err != nil {
+		require.Fail(t, fmt.Sprintf("httprecord: body of request %s is not valid JSON: %v\nbody: %s", req, err, req.Body), msgAndArgs...)
+		return
+	}
+	if !reflect.DeepEqual(expectedValue, actualValue) {
+		require.Fail(t, fmt.Sprintf("httprecord: body of request %s mismatch:\nexpected: %s\nactual  : %s", req, expected, req.Body), msgAndArgs...)
+	}
+}
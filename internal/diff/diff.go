@@ -0,0 +1,310 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package diff computes differences of texts (line by line) and values
+// (element by element for slices, key by key for maps and field by field
+// for structs), for showing them in failure messages and tools.
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines shown around changes.
+const contextLines = 3
+
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// editOp is an operation of an edit script: kind ' ' keeps element a of
+// the first sequence (equal to element b of the second one), '-' removes
+// element a, and '+' inserts element b.
+type editOp struct {
+	kind byte
+	a, b int
+}
+
+// maxEditCost bounds the number of comparisons made by a bisect of a
+// region of the sequences, after which the region is reported as removed
+// and inserted as a whole, so that long and very different sequences do
+// not take quadratic time.
+const maxEditCost = 1 << 22
+
+// editScript computes the edit script from a sequence of n elements to a
+// sequence of m elements, using the linear space variant of the Myers
+// diff algorithm, where equal(i, j) reports if element i of the first
+// sequence is equal to element j of the second one.
+func editScript(n, m int, equal func(i, j int) bool) []editOp {
+	s := &scripter{equal: equal}
+	s.compare(0, n, 0, m)
+	return s.ops
+}
+
+// scripter builds an edit script, see editScript.
+type scripter struct {
+	equal func(i, j int) bool
+	ops   []editOp
+}
+
+// compare appends the edit script from elements a0 to a1 (excluded) of the
+// first sequence to elements b0 to b1 of the second one.
+func (s *scripter) compare(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && s.equal(a0, b0) {
+		s.ops = append(s.ops, editOp{' ', a0, b0})
+		a0++
+		b0++
+	}
+	suffix := 0
+	for a0 < a1-suffix && b0 < b1-suffix && s.equal(a1-suffix-1, b1-suffix-1) {
+		suffix++
+	}
+	a1 -= suffix
+	b1 -= suffix
+	if x, y, ok := s.bisect(a0, a1, b0, b1); ok {
+		s.compare(a0, x, b0, y)
+		s.compare(x, a1, y, b1)
+	} else {
+		for i := a0; i < a1; i++ {
+			s.ops = append(s.ops, editOp{'-', i, b0})
+		}
+		for j := b0; j < b1; j++ {
+			s.ops = append(s.ops, editOp{'+', a1, j})
+		}
+	}
+	for k := 0; k < suffix; k++ {
+		s.ops = append(s.ops, editOp{' ', a1 + k, b1 + k})
+	}
+}
+
+// bisect finds the middle of a shortest edit script from elements a0 to
+// a1 of the first sequence to elements b0 to b1 of the second one, which
+// must not start or end with equal elements, by searching paths from both
+// ends until they overlap. It returns false if the regions have nothing in
+// common, or if the search exceeds maxEditCost.
+func (s *scripter) bisect(a0, a1, b0, b1 int) (x, y int, ok bool) {
+	n, m := a1-a0, b1-b0
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+	maxD := (n + m + 1) / 2
+	cost := 0
+	offset := maxD
+	// forward[offset+k] and backward[offset+k] are the furthest x reached
+	// on diagonal k from the start and from the end of the regions
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+	delta := n - m
+	front := delta%2 != 0
+	// the diagonals beyond the regions are skipped
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+	for d := 0; d < maxD && cost < maxEditCost; d++ {
+		for k1 := -d + k1start; k1 <= d-k1end; k1 += 2 {
+			i := offset + k1
+			var x1 int
+			if k1 == -d || (k1 != d && forward[i-1] < forward[i+1]) {
+				x1 = forward[i+1]
+			} else {
+				x1 = forward[i-1] + 1
+			}
+			y1 := x1 - k1
+			start := x1
+			for x1 < n && y1 < m && s.equal(a0+x1, b0+y1) {
+				x1++
+				y1++
+			}
+			cost += x1 - start + 1
+			forward[i] = x1
+			switch {
+			case x1 > n:
+				k1end += 2
+			case y1 > m:
+				k1start += 2
+			case front:
+				j := offset + delta - k1
+				if j >= 0 && j < len(backward) && backward[j] != -1 && x1 >= n-backward[j] {
+					return s.split(a0, a1, b0, b1, x1, y1)
+				}
+			}
+		}
+		for k2 := -d + k2start; k2 <= d-k2end; k2 += 2 {
+			i := offset + k2
+			var x2 int
+			if k2 == -d || (k2 != d && backward[i-1] < backward[i+1]) {
+				x2 = backward[i+1]
+			} else {
+				x2 = backward[i-1] + 1
+			}
+			y2 := x2 - k2
+			start := x2
+			for x2 < n && y2 < m && s.equal(a1-x2-1, b1-y2-1) {
+				x2++
+				y2++
+			}
+			cost += x2 - start + 1
+			backward[i] = x2
+			switch {
+			case x2 > n:
+				k2end += 2
+			case y2 > m:
+				k2start += 2
+			case !front:
+				j := offset + delta - k2
+				if j >= 0 && j < len(forward) && forward[j] != -1 {
+					x1 := forward[j]
+					y1 := offset + x1 - j
+					if x1 >= n-x2 {
+						return s.split(a0, a1, b0, b1, x1, y1)
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// split returns the point at x, y from the start of the regions, unless
+// it is one of their ends, which would not divide the problem.
+func (s *scripter) split(a0, a1, b0, b1, x, y int) (int, int, bool) {
+	if (x == 0 && y == 0) || (a0+x == a1 && b0+y == b1) {
+		return 0, 0, false
+	}
+	return a0 + x, b0 + y, true
+}
+
+// lineDiff computes the line-by-line edit script from a to b.
+func lineDiff(a, b []string) []diffOp {
+	script := editScript(len(a), len(b), func(i, j int) bool {
+		return a[i] == b[j]
+	})
+	ops := make([]diffOp, len(script))
+	for k, op := range script {
+		line := a[op.a]
+		if op.kind == '+' {
+			line = b[op.b]
+		}
+		ops[k] = diffOp{op.kind, line}
+	}
+	return ops
+}
+
+// Unified returns the changes from before to after in unified format, or
+// empty string if they are equal.
+func Unified(beforeName, afterName, before, after string) string {
+	if before == after {
+		return ""
+	}
+	ops := lineDiff(splitLines(before), splitLines(after))
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", beforeName, afterName)
+	for _, hunk := range hunks(ops) {
+		writeHunk(&sb, ops, hunk[0], hunk[1])
+	}
+	return sb.String()
+}
+
+// hunks returns the start and end indexes of the groups of changes in ops,
+// with up to contextLines unchanged operations around the changes.
+func hunks(ops []diffOp) [][2]int {
+	var result [][2]int
+	for start := 0; start < len(ops); {
+		// find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		// extend the hunk until contextLines*2 unchanged lines in a row
+		end := start
+		for unchanged := 0; end < len(ops) && unchanged <= 2*contextLines; end++ {
+			if ops[end].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		hunkStart := start - contextLines
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+		hunkEnd := end
+		for hunkEnd > start && ops[hunkEnd-1].kind == ' ' {
+			hunkEnd--
+		}
+		hunkEnd += contextLines
+		if hunkEnd > len(ops) {
+			hunkEnd = len(ops)
+		}
+		result = append(result, [2]int{hunkStart, hunkEnd})
+		start = hunkEnd
+	}
+	return result
+}
+
+func writeHunk(sb *strings.Builder, ops []diffOp, start, end int) {
+	// line numbers (1-based) of the hunk in a and b
+	aLine, bLine := 1, 1
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			aLine++
+		}
+		if op.kind != '-' {
+			bLine++
+		}
+	}
+	aCount, bCount := 0, 0
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			aCount++
+		}
+		if op.kind != '-' {
+			bCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%d,%d +%d,%d @@\n", aLine, aCount, bLine, bCount)
+	for _, op := range ops[start:end] {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// splitLines splits s into lines, keeping the line endings.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
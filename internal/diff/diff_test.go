@@ -0,0 +1,130 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package diff
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// lcsLength returns the length of the longest common subsequence of a and
+// b, computed with the quadratic table.
+func lcsLength(a, b []int) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			switch {
+			case a[i] == b[j]:
+				cur[j+1] = prev[j] + 1
+			case prev[j+1] >= cur[j]:
+				cur[j+1] = prev[j+1]
+			default:
+				cur[j+1] = cur[j]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// checkScript checks that script transforms a into b, and returns the
+// number of kept elements.
+func checkScript(t *testing.T, a, b []int, script []editOp) int {
+	t.Helper()
+	i, j, kept := 0, 0, 0
+	for _, op := range script {
+		switch op.kind {
+		case ' ':
+			if op.a != i || op.b != j || a[i] != b[j] {
+				t.Fatalf("invalid kept element %+v at %d, %d of %v and %v", op, i, j, a, b)
+			}
+			i++
+			j++
+			kept++
+		case '-':
+			if op.a != i {
+				t.Fatalf("invalid removal %+v at %d of %v", op, i, a)
+			}
+			i++
+		case '+':
+			if op.b != j {
+				t.Fatalf("invalid insertion %+v at %d of %v", op, j, b)
+			}
+			j++
+		}
+	}
+	if i != len(a) || j != len(b) {
+		t.Fatalf("script ends at %d, %d of %v and %v", i, j, a, b)
+	}
+	return kept
+}
+
+func TestEditScript(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n, values int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = rnd.Intn(values)
+		}
+		return s
+	}
+	for k := 0; k < 2000; k++ {
+		a := random(rnd.Intn(30), 1+rnd.Intn(5))
+		b := random(rnd.Intn(30), 1+rnd.Intn(5))
+		script := editScript(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+		if kept, expected := checkScript(t, a, b, script), lcsLength(a, b); kept != expected {
+			t.Fatalf("script of %v and %v keeps %d elements, expected %d", a, b, kept, expected)
+		}
+	}
+}
+
+func TestEditScriptLarge(t *testing.T) {
+	n := 100000
+	a := make([]int, n)
+	b := make([]int, n)
+	for i := range a {
+		a[i] = i
+		b[i] = i
+		if i%1000 == 0 {
+			b[i] = -1 - i
+		}
+	}
+	start := time.Now()
+	script := editScript(n, n, func(i, j int) bool { return a[i] == b[j] })
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("editScript took %v", elapsed)
+	}
+	if kept := checkScript(t, a, b, script); kept != n-n/1000 {
+		t.Errorf("script keeps %d elements, expected %d", kept, n-n/1000)
+	}
+
+	// nothing in common: the search is bounded by maxEditCost
+	for i := range b {
+		b[i] = -1 - i
+	}
+	script = editScript(n, n, func(i, j int) bool { return a[i] == b[j] })
+	checkScript(t, a, b, script)
+}
@@ -0,0 +1,141 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// missing is shown in tables for paths that exist in only one value.
+const missing = "<missing>"
+
+// Table returns the differences of expected and actual as a table of
+// "path | expected | actual" rows, sorted by path, with a row for each
+// leaf value (at any depth of structs, maps, arrays and slices) that
+// differs, or empty string if they are equal:
+//
+//	path          | expected  | actual
+//	value.Name    | "alice"   | "bob"
+//	value.Tags[1] | <missing> | "admin"
+//
+// Paths start with "value", like the paths of require.EqualWith.
+func Table(expected, actual any) string {
+	expectedLeaves := map[string]string{}
+	actualLeaves := map[string]string{}
+	flatten("value", reflect.ValueOf(expected), expectedLeaves, map[uintptr]bool{})
+	flatten("value", reflect.ValueOf(actual), actualLeaves, map[uintptr]bool{})
+
+	var rows [][3]string
+	for path, e := range expectedLeaves {
+		a, ok := actualLeaves[path]
+		if !ok {
+			a = missing
+		}
+		if a != e {
+			rows = append(rows, [3]string{path, e, a})
+		}
+	}
+	for path, a := range actualLeaves {
+		if _, ok := expectedLeaves[path]; !ok {
+			rows = append(rows, [3]string{path, missing, a})
+		}
+	}
+	if len(rows) == 0 {
+		return ""
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0] < rows[j][0]
+	})
+	rows = append([][3]string{{"path", "expected", "actual"}}, rows...)
+
+	var widths [2]int
+	for _, row := range rows {
+		for i := range widths {
+			if len(row[i]) > widths[i] {
+				widths[i] = len(row[i])
+			}
+		}
+	}
+	var sb strings.Builder
+	for _, row := range rows {
+		fmt.Fprintf(&sb, "%-*s | %-*s | %s\n", widths[0], row[0], widths[1], row[1], row[2])
+	}
+	return sb.String()
+}
+
+// flatten adds the formatted leaf values of v, by path, to leaves.
+// Collections and structs without elements or fields are leaves too, so
+// that an empty slice and a missing one are distinguished.
+func flatten(path string, v reflect.Value, leaves map[string]string, visited map[uintptr]bool) {
+	if !v.IsValid() {
+		leaves[path] = "<nil>"
+		return
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			leaves[path] = formatValue(v)
+			return
+		}
+		if v.Kind() == reflect.Ptr {
+			if visited[v.Pointer()] {
+				leaves[path] = fmt.Sprintf("<cycle %#x>", v.Pointer())
+				return
+			}
+			visited[v.Pointer()] = true
+			defer delete(visited, v.Pointer())
+		}
+		flatten(path, v.Elem(), leaves, visited)
+		return
+	case reflect.Struct:
+		if v.NumField() == 0 {
+			break
+		}
+		for i := 0; i < v.NumField(); i++ {
+			flatten(path+"."+v.Type().Field(i).Name, v.Field(i), leaves, visited)
+		}
+		return
+	case reflect.Map:
+		if v.Len() == 0 {
+			break
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			flatten(fmt.Sprintf("%s[%s]", path, formatValue(iter.Key())), iter.Value(), leaves, visited)
+		}
+		return
+	case reflect.Array, reflect.Slice:
+		if v.Len() == 0 || v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for i := 0; i < v.Len(); i++ {
+			flatten(fmt.Sprintf("%s[%d]", path, i), v.Index(i), leaves, visited)
+		}
+		return
+	}
+	leaves[path] = formatValue(v)
+}
@@ -0,0 +1,183 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Values returns the differences of expected and actual values of the same
+// type, or empty string if they can not be shown better than the values
+// themselves (such as for numbers or single-line strings), or are equal:
+//   - multi-line strings are compared line by line, see Unified
+//   - arrays and slices are compared element by element
+//   - maps are compared key by key, and structs field by field, showing
+//     only the keys and fields that differ
+//
+// Removed (expected) lines are prefixed with "-" and added (actual) lines
+// with "+".
+func Values(expected, actual any) string {
+	return values(reflect.ValueOf(expected), reflect.ValueOf(actual))
+}
+
+func values(expected, actual reflect.Value) string {
+	if !expected.IsValid() || !actual.IsValid() || expected.Type() != actual.Type() {
+		return ""
+	}
+	switch expected.Kind() {
+	case reflect.String:
+		e, a := expected.String(), actual.String()
+		if !strings.Contains(e, "\n") && !strings.Contains(a, "\n") {
+			return ""
+		}
+		return Unified("expected", "actual", e, a)
+	case reflect.Array, reflect.Slice:
+		if expected.Type().Elem().Kind() == reflect.Uint8 {
+			// bytes are not shown one per line
+			return ""
+		}
+		return elements(expected, actual)
+	case reflect.Map:
+		return keys(expected, actual)
+	case reflect.Struct:
+		return fields(expected, actual)
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			return ""
+		}
+		return values(expected.Elem(), actual.Elem())
+	}
+	return ""
+}
+
+// formatValue formats a value, possibly of an unexported field.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v.String())
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// equal compares values deeply, or by their formatting if they can not be
+// compared with reflect.DeepEqual, such as values of unexported fields.
+func equal(a, b reflect.Value) bool {
+	if a.CanInterface() && b.CanInterface() {
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+	return formatValue(a) == formatValue(b)
+}
+
+// elements returns the element by element differences of arrays or slices.
+func elements(expected, actual reflect.Value) string {
+	script := editScript(expected.Len(), actual.Len(), func(i, j int) bool {
+		return equal(expected.Index(i), actual.Index(j))
+	})
+	ops := make([]diffOp, len(script))
+	changed := false
+	for k, op := range script {
+		index, value := op.a, expected
+		if op.kind == '+' {
+			index, value = op.b, actual
+		}
+		ops[k] = diffOp{op.kind, fmt.Sprintf("[%d]: %s", index, formatValue(value.Index(index)))}
+		changed = changed || op.kind != ' '
+	}
+	if !changed {
+		return ""
+	}
+	var sb strings.Builder
+	end := 0
+	for _, hunk := range hunks(ops) {
+		if hunk[0] > end {
+			sb.WriteString("  ...\n")
+		}
+		for _, op := range ops[hunk[0]:hunk[1]] {
+			fmt.Fprintf(&sb, "%c %s\n", op.kind, op.line)
+		}
+		end = hunk[1]
+	}
+	if end < len(ops) {
+		sb.WriteString("  ...\n")
+	}
+	return sb.String()
+}
+
+// keys returns the key by key differences of maps, in the order of the
+// formatted keys.
+func keys(expected, actual reflect.Value) string {
+	type entry struct {
+		key  string
+		diff string
+	}
+	var entries []entry
+	iter := expected.MapRange()
+	for iter.Next() {
+		key := formatValue(iter.Key())
+		actualValue := actual.MapIndex(iter.Key())
+		switch {
+		case !actualValue.IsValid():
+			entries = append(entries, entry{key, fmt.Sprintf("- %s: %s\n", key, formatValue(iter.Value()))})
+		case !equal(iter.Value(), actualValue):
+			entries = append(entries, entry{key, fmt.Sprintf(
+				"- %s: %s\n+ %s: %s\n",
+				key, formatValue(iter.Value()), key, formatValue(actualValue),
+			)})
+		}
+	}
+	iter = actual.MapRange()
+	for iter.Next() {
+		if !expected.MapIndex(iter.Key()).IsValid() {
+			key := formatValue(iter.Key())
+			entries = append(entries, entry{key, fmt.Sprintf("+ %s: %s\n", key, formatValue(iter.Value()))})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+	var sb strings.Builder
+	for _, e := range entries {
+		sb.WriteString(e.diff)
+	}
+	return sb.String()
+}
+
+// fields returns the field by field differences of structs.
+func fields(expected, actual reflect.Value) string {
+	var sb strings.Builder
+	for i := 0; i < expected.NumField(); i++ {
+		e, a := expected.Field(i), actual.Field(i)
+		if equal(e, a) {
+			continue
+		}
+		name := expected.Type().Field(i).Name
+		fmt.Fprintf(&sb, "- %s: %s\n+ %s: %s\n", name, formatValue(e), name, formatValue(a))
+	}
+	return sb.String()
+}
@@ -0,0 +1,398 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool // typ is the type of elements
+}
+
+// function is an exported function whose first parameter is t TestingT.
+type function struct {
+	name       string
+	typeParams []param
+	params     []param // without the first parameter
+	results    []string
+}
+
+// pkgInfo is the parsed source of a package, excluding generated files.
+type pkgInfo struct {
+	name    string
+	funcs   map[string]*function
+	types   map[string]bool   // exported type names
+	imports map[string]string // import name -> path
+}
+
+const (
+	requirePath = "github.com/ilius/demand/require"
+	assertPath  = "github.com/ilius/demand/testify/assert"
+)
+
+func loadPackage(dir string) (*pkgInfo, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	info := &pkgInfo{
+		funcs:   map[string]*function{},
+		types:   map[string]bool{},
+		imports: map[string]string{},
+	}
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if isGenerated(file) {
+			continue
+		}
+		info.name = file.Name.Name
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := filepath.Base(path)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			info.imports[name] = path
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				if decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					if name := spec.(*ast.TypeSpec).Name; name.IsExported() {
+						info.types[name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				if f := newFunction(fset, decl); f != nil {
+					info.funcs[f.name] = f
+				}
+			}
+		}
+	}
+	if info.name == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return info, nil
+}
+
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, "// Code generated ") && strings.HasSuffix(comment.Text, " DO NOT EDIT.") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func newFunction(fset *token.FileSet, decl *ast.FuncDecl) *function {
+	if decl.Recv != nil || !decl.Name.IsExported() || helperFuncs[decl.Name.Name] {
+		return nil
+	}
+	params := decl.Type.Params.List
+	if len(params) == 0 || len(params[0].Names) != 1 || params[0].Names[0].Name != "t" {
+		return nil
+	}
+	if ident, ok := params[0].Type.(*ast.Ident); !ok || ident.Name != "TestingT" {
+		return nil
+	}
+	f := &function{
+		name:   decl.Name.Name,
+		params: fieldParams(fset, params[1:]),
+	}
+	if decl.Type.TypeParams != nil {
+		f.typeParams = fieldParams(fset, decl.Type.TypeParams.List)
+	}
+	if decl.Type.Results != nil {
+		for _, field := range decl.Type.Results.List {
+			f.results = append(f.results, exprString(fset, field.Type))
+		}
+	}
+	if len(f.results) == 1 && constructorResults[f.results[0]] {
+		return nil
+	}
+	return f
+}
+
+// constructorResults are the result types of functions taking a TestingT
+// that are constructors or TestingT wrappers, not assertions.
+var constructorResults = map[string]bool{
+	"*Assertions":  true,
+	"*Collector":   true,
+	"*Expectation": true,
+	"TestingT":     true,
+}
+
+// helperFuncs are the functions taking a TestingT that are helpers for
+// writing assertions, not assertions.
+var helperFuncs = map[string]bool{
+	"TestDeadline": true,
+}
+
+func fieldParams(fset *token.FileSet, fields []*ast.Field) []param {
+	var params []param
+	for _, field := range fields {
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = ellipsis.Elt
+			variadic = true
+		}
+		for _, name := range field.Names {
+			params = append(params, param{
+				name:     name.Name,
+				typ:      exprString(fset, typ),
+				variadic: variadic,
+			})
+		}
+	}
+	return params
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	_ = printer.Fprint(&buf, fset, expr)
+	return buf.String()
+}
+
+// hasMsgAndArgs reports if the last parameter is msgAndArgs ...any.
+func (f *function) hasMsgAndArgs() bool {
+	if len(f.params) == 0 {
+		return false
+	}
+	last := f.params[len(f.params)-1]
+	return last.name == "msgAndArgs" && last.variadic
+}
+
+// format returns the f-variant of f.
+func (f *function) format() *function {
+	params := append([]param{}, f.params[:len(f.params)-1]...)
+	params = append(params,
+		param{name: "msg", typ: "string"},
+		param{name: "args", typ: "any", variadic: true},
+	)
+	return &function{
+		name:       f.name + "f",
+		typeParams: f.typeParams,
+		params:     params,
+		results:    f.results,
+	}
+}
+
+// typeParamsDecl returns the type parameter list, or empty string.
+func (f *function) typeParamsDecl(qualify func(string) string) string {
+	if len(f.typeParams) == 0 {
+		return ""
+	}
+	return "[" + paramsDecl(f.typeParams, qualify) + "]"
+}
+
+// typeArgs returns the type parameters as explicit type arguments for
+// calling f from a function with the same type parameters, or empty string.
+func (f *function) typeArgs() string {
+	if len(f.typeParams) == 0 {
+		return ""
+	}
+	names := make([]string, len(f.typeParams))
+	for i, p := range f.typeParams {
+		names[i] = p.name
+	}
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
+// paramsDecl returns the parameters without the first t parameter.
+func (f *function) paramsDecl(qualify func(string) string) string {
+	return paramsDecl(f.params, qualify)
+}
+
+func paramsDecl(params []param, qualify func(string) string) string {
+	parts := make([]string, len(params))
+	for i, p := range params {
+		typ := qualify(p.typ)
+		if p.variadic {
+			typ = "..." + typ
+		}
+		parts[i] = p.name + " " + typ
+	}
+	return strings.Join(parts, ", ")
+}
+
+// args returns the arguments to pass the parameters (without t) to
+// another function with the same signature.
+func (f *function) args() string {
+	parts := make([]string, len(f.params))
+	for i, p := range f.params {
+		parts[i] = p.name
+		if p.variadic {
+			parts[i] += "..."
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatArgs returns the arguments to pass the parameters of the
+// f-variant f (without t) to the function with msgAndArgs.
+func (f *function) formatArgs() string {
+	parts := make([]string, 0, len(f.params))
+	for _, p := range f.params[:len(f.params)-2] {
+		parts = append(parts, p.name)
+	}
+	parts = append(parts, "append([]any{msg}, args...)...")
+	return strings.Join(parts, ", ")
+}
+
+func (f *function) resultsDecl(qualify func(string) string) string {
+	switch len(f.results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + qualify(f.results[0])
+	}
+	parts := make([]string, len(f.results))
+	for i, r := range f.results {
+		parts[i] = qualify(r)
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
+// withFormat returns the functions of pkg, along with the f-variants
+// that are not defined in pkg, sorted by name.
+func withFormat(pkg *pkgInfo) (all []*function, derived []*function) {
+	for _, f := range pkg.funcs {
+		all = append(all, f)
+		if !f.hasMsgAndArgs() || pkg.funcs[f.name+"f"] != nil {
+			continue
+		}
+		ff := f.format()
+		all = append(all, ff)
+		derived = append(derived, ff)
+	}
+	sortFunctions(all)
+	sortFunctions(derived)
+	return all, derived
+}
+
+func sortFunctions(funcs []*function) {
+	sort.Slice(funcs, func(i, j int) bool {
+		return funcs[i].name < funcs[j].name
+	})
+}
+
+var typeNameRe = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
+
+// qualifier returns a function that qualifies the exported type names of
+// the package with the given name in a type expression, except types
+// that are also defined by the target package.
+func qualifier(pkgName string, pkgTypes map[string]bool, target *pkgInfo) func(string) string {
+	return func(typ string) string {
+		return typeNameRe.ReplaceAllStringFunc(typ, func(match string) string {
+			sub := typeNameRe.FindStringSubmatch(match)
+			name := sub[2]
+			if !pkgTypes[name] || (target != nil && target.types[name]) {
+				return match
+			}
+			return sub[1] + pkgName + "." + name
+		})
+	}
+}
+
+func noQualify(typ string) string {
+	return typ
+}
+
+// writeFile formats and writes a generated Go file, importing the
+// packages of imports that are used by body.
+func writeFile(path string, pkgName string, body string, imports map[string]string) error {
+	var std, other []string
+	for name, importPath := range imports {
+		if !regexp.MustCompile(`\b` + name + `\.`).MatchString(body) {
+			continue
+		}
+		spec := strconv.Quote(importPath)
+		if filepath.Base(importPath) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(importPath, "/")[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	var buf bytes.Buffer
+	buf.WriteString(licenseHeader)
+	buf.WriteString("\n// Code generated by internal/gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n", pkgName)
+	if len(std)+len(other) > 0 {
+		buf.WriteString("\nimport (\n")
+		for _, spec := range std {
+			fmt.Fprintf(&buf, "\t%s\n", spec)
+		}
+		if len(std) > 0 && len(other) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, spec := range other {
+			fmt.Fprintf(&buf, "\t%s\n", spec)
+		}
+		buf.WriteString(")\n")
+	}
+	buf.WriteString(body)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,69 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Command gen generates the code derived from the canonical functions of
+// require package, so that they don't need to be maintained by hand:
+//
+//   - require: the f-variants (require_format.go) and *Assertions methods
+//     (require_forward.go)
+//   - check: the error-returning functions (check_forward.go)
+//   - assert: testify-compatible bool-returning functions, their f-variants
+//     and *Assertions methods (assertions.go, assertion_format.go and
+//     assertion_forward.go)
+//   - testify-require: testify-compatible require functions and
+//     *Assertions methods (require.go and require_forward.go)
+//
+// It is run by go:generate in the directory of the target package:
+//
+//	go run ../internal/gen require
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: gen require|check|assert|testify-require")
+		os.Exit(2)
+	}
+	var err error
+	switch mode := os.Args[1]; mode {
+	case "require":
+		err = genRequire(".")
+	case "check":
+		err = genCheck(filepath.Join("..", "require"), ".")
+	case "assert":
+		err = genAssert(filepath.Join("..", "..", "require"), ".")
+	case "testify-require":
+		err = genTestifyRequire(filepath.Join("..", "..", "require"), filepath.Join("..", "assert"), ".")
+	default:
+		err = fmt.Errorf("unknown mode %q", mode)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gen:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,365 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// testifyFuncs are the functions of testify's assert and require packages
+// that are provided by testify/assert and testify/require (along with their
+// f-variants and *Assertions methods).
+var testifyFuncs = []string{
+	"Condition",
+	"Contains",
+	"DirExists",
+	"ElementsMatch",
+	"Empty",
+	"Equal",
+	"EqualError",
+	"EqualExportedValues",
+	"EqualValues",
+	"Error",
+	"ErrorAs",
+	"ErrorContains",
+	"ErrorIs",
+	"Eventually",
+	"EventuallyWithT",
+	"Exactly",
+	"Fail",
+	"FailNow",
+	"False",
+	"FileExists",
+	"Greater",
+	"GreaterOrEqual",
+	"HTTPBodyContains",
+	"HTTPBodyNotContains",
+	"HTTPError",
+	"HTTPRedirect",
+	"HTTPStatusCode",
+	"HTTPSuccess",
+	"Implements",
+	"InDelta",
+	"InDeltaMapValues",
+	"InDeltaSlice",
+	"InEpsilon",
+	"InEpsilonSlice",
+	"IsDecreasing",
+	"IsIncreasing",
+	"IsNonDecreasing",
+	"IsNonIncreasing",
+	"IsType",
+	"JSONEq",
+	"Len",
+	"Less",
+	"LessOrEqual",
+	"Negative",
+	"Never",
+	"Nil",
+	"NoDirExists",
+	"NoError",
+	"NoFileExists",
+	"NotContains",
+	"NotEmpty",
+	"NotEqual",
+	"NotEqualValues",
+	"NotErrorIs",
+	"NotImplements",
+	"NotNil",
+	"NotPanics",
+	"NotRegexp",
+	"NotSame",
+	"NotSubset",
+	"NotZero",
+	"Panics",
+	"PanicsWithError",
+	"PanicsWithValue",
+	"Positive",
+	"Regexp",
+	"Same",
+	"Subset",
+	"True",
+	"WithinDuration",
+	"WithinRange",
+	"YAMLEq",
+	"Zero",
+}
+
+// checkSkip are require functions that have no check equivalent.
+var checkSkip = map[string]bool{
+	"Assume":           true,
+	"Assumef":          true,
+	"AssumeNoError":    true,
+	"AssumeNoErrorf":   true,
+	"ExitsWith":        true,
+	"ExitsWithf":       true,
+	"ExitsWithStderr":  true,
+	"ExitsWithStderrf": true,
+	"Group":            true,
+	"SkipIfShort":      true,
+	"SkipIfShortf":     true,
+	"SkipOnOS":         true,
+	"SkipOnOSf":        true,
+}
+
+const helperCall = `if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}`
+
+const methodHelperCall = `if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}`
+
+func genRequire(dir string) error {
+	pkg, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+	all, derived := withFormat(pkg)
+
+	var format strings.Builder
+	for _, f := range derived {
+		call := fmt.Sprintf("%s%s(t, %s)", strings.TrimSuffix(f.name, "f"), f.typeArgs(), f.formatArgs())
+		if len(f.results) > 0 {
+			call = "return " + call
+		}
+		fmt.Fprintf(
+			&format,
+			"\n// %s is like %s, but the message is given as a format string and arguments.\nfunc %s%s(t TestingT, %s)%s {\n\tt.Helper()\n\t%s\n\t%s\n}\n",
+			f.name, strings.TrimSuffix(f.name, "f"),
+			f.name, f.typeParamsDecl(noQualify), f.paramsDecl(noQualify), f.resultsDecl(noQualify),
+			interceptCall("t", f), call,
+		)
+	}
+	err = writeFile(filepath.Join(dir, "require_format.go"), pkg.name, format.String(), pkg.imports)
+	if err != nil {
+		return err
+	}
+
+	var forward strings.Builder
+	for _, f := range all {
+		if len(f.typeParams) > 0 {
+			// methods can't have type parameters
+			continue
+		}
+		call := fmt.Sprintf("%s(a.t, %s)", f.name, f.args())
+		if len(f.results) > 0 {
+			call = "return " + call
+		}
+		fmt.Fprintf(
+			&forward,
+			"\nfunc (a *Assertions) %s(%s)%s {\n\ta.t.Helper()\n\t%s\n\t%s\n}\n",
+			f.name, f.paramsDecl(noQualify), f.resultsDecl(noQualify), interceptCall("a.t", f), call,
+		)
+	}
+	return writeFile(filepath.Join(dir, "require_forward.go"), pkg.name, forward.String(), pkg.imports)
+}
+
+// interceptCall returns the code of an f-variant or a forwarding method of
+// f calling the interceptors of the TestingT t, see require.Intercept.
+func interceptCall(t string, f *function) string {
+	names := []string{t, strconv.Quote(f.name)}
+	for _, p := range f.params {
+		names = append(names, p.name)
+	}
+	return fmt.Sprintf("if it := findIntercept(%s); it != nil {\n\t\tdefer it.intercept(%s)()\n\t}", t, strings.Join(names, ", "))
+}
+
+func genCheck(requireDir string, dir string) error {
+	req, err := loadPackage(requireDir)
+	if err != nil {
+		return err
+	}
+	target, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+	all, _ := withFormat(req)
+	qualify := qualifier("require", req.types, target)
+
+	var body strings.Builder
+	for _, f := range all {
+		if checkSkip[f.name] || (len(f.results) > 0 && (len(f.results) > 1 || f.results[0] != "bool")) {
+			continue
+		}
+		fmt.Fprintf(
+			&body,
+			"\nfunc %s%s(%s) error {\n\treturn capture(func(t require.TestingT) {\n\t\trequire.%s%s(t, %s)\n\t})\n}\n",
+			f.name, f.typeParamsDecl(qualify), f.paramsDecl(qualify), f.name, f.typeArgs(), f.args(),
+		)
+	}
+	imports := withImport(req.imports, "require", requirePath)
+	return writeFile(filepath.Join(dir, "check_forward.go"), target.name, body.String(), imports)
+}
+
+// testifyAssertFuncs returns the functions of testify/assert, which are
+// either defined by hand in assert, or generated from require functions,
+// and reports which ones are generated.
+func testifyAssertFuncs(req *pkgInfo, assert *pkgInfo) ([]*function, map[string]bool, error) {
+	var funcs []*function
+	generated := map[string]bool{}
+	for _, name := range testifyFuncs {
+		if f := assert.funcs[name]; f != nil {
+			funcs = append(funcs, f)
+			continue
+		}
+		f := req.funcs[name]
+		if f == nil {
+			return nil, nil, fmt.Errorf("require.%s is not defined", name)
+		}
+		funcs = append(funcs, f)
+		generated[name] = true
+	}
+	return funcs, generated, nil
+}
+
+var anyRe = regexp.MustCompile(`\bany\b`)
+
+// testifyQualifier is like qualifier, but also replaces any with
+// interface{} to match the signatures of testify.
+func testifyQualifier(pkgName string, pkgTypes map[string]bool, target *pkgInfo) func(string) string {
+	qualify := qualifier(pkgName, pkgTypes, target)
+	return func(typ string) string {
+		return anyRe.ReplaceAllString(qualify(typ), "interface{}")
+	}
+}
+
+func genAssert(requireDir string, dir string) error {
+	req, err := loadPackage(requireDir)
+	if err != nil {
+		return err
+	}
+	target, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+	funcs, generated, err := testifyAssertFuncs(req, target)
+	if err != nil {
+		return err
+	}
+	qualify := testifyQualifier("require", req.types, target)
+	imports := withImport(req.imports, "require", requirePath)
+
+	var assertions, format, forward strings.Builder
+	for _, f := range funcs {
+		if generated[f.name] {
+			fmt.Fprintf(
+				&assertions,
+				"\nfunc %s(t TestingT, %s) bool {\n\t%s\n\treturn run(t, func(t require.TestingT) {\n\t\trequire.%s(t, %s)\n\t})\n}\n",
+				f.name, f.paramsDecl(qualify), helperCall, f.name, f.args(),
+			)
+		}
+		ff := f.format()
+		fmt.Fprintf(
+			&format,
+			"\nfunc %s(t TestingT, %s) bool {\n\t%s\n\treturn %s(t, %s)\n}\n",
+			ff.name, ff.paramsDecl(qualify), helperCall, f.name, ff.formatArgs(),
+		)
+		for _, m := range []*function{f, ff} {
+			fmt.Fprintf(
+				&forward,
+				"\nfunc (a *Assertions) %s(%s) bool {\n\t%s\n\treturn %s(a.t, %s)\n}\n",
+				m.name, m.paramsDecl(qualify), methodHelperCall, m.name, m.args(),
+			)
+		}
+	}
+	files := []struct {
+		name string
+		body string
+	}{
+		{"assertions.go", assertions.String()},
+		{"assertion_format.go", format.String()},
+		{"assertion_forward.go", forward.String()},
+	}
+	for _, file := range files {
+		err := writeFile(filepath.Join(dir, file.name), target.name, file.body, imports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func genTestifyRequire(requireDir string, assertDir string, dir string) error {
+	req, err := loadPackage(requireDir)
+	if err != nil {
+		return err
+	}
+	assert, err := loadPackage(assertDir)
+	if err != nil {
+		return err
+	}
+	target, err := loadPackage(dir)
+	if err != nil {
+		return err
+	}
+	funcs, _, err := testifyAssertFuncs(req, assert)
+	if err != nil {
+		return err
+	}
+	assertTypes := map[string]bool{}
+	for name := range req.types {
+		assertTypes[name] = true
+	}
+	for name := range assert.types {
+		assertTypes[name] = true
+	}
+	qualify := testifyQualifier("assert", assertTypes, target)
+	imports := withImport(req.imports, "assert", assertPath)
+
+	var body, forward strings.Builder
+	for _, f := range funcs {
+		for _, m := range []*function{f, f.format()} {
+			fmt.Fprintf(
+				&body,
+				"\nfunc %s(t TestingT, %s) {\n\t%s\n\tif assert.%s(t, %s) {\n\t\treturn\n\t}\n\tt.FailNow()\n}\n",
+				m.name, m.paramsDecl(qualify), helperCall, m.name, m.args(),
+			)
+			fmt.Fprintf(
+				&forward,
+				"\nfunc (a *Assertions) %s(%s) {\n\t%s\n\t%s(a.t, %s)\n}\n",
+				m.name, m.paramsDecl(qualify), methodHelperCall, m.name, m.args(),
+			)
+		}
+	}
+	if err := writeFile(filepath.Join(dir, "require.go"), target.name, body.String(), imports); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, "require_forward.go"), target.name, forward.String(), imports)
+}
+
+// withImport returns a copy of imports with the given import added.
+func withImport(imports map[string]string, name string, path string) map[string]string {
+	result := map[string]string{name: path}
+	for n, p := range imports {
+		if n != name {
+			result[n] = p
+		}
+	}
+	return result
+}
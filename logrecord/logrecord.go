@@ -0,0 +1,343 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package logrecord provides a slog.Handler that records log records of
+// code under test, with assertions on the recorded records:
+//
+//	rec := logrecord.Capture(t)
+//	DeleteUser("alice")
+//	rec.LogContains(t, slog.LevelWarn, "user not found")
+//	rec.NoLogsAtLevel(t, slog.LevelError)
+//
+// Capture records the output of the default slog.Logger and the log
+// package functions. A Recorder can also be given explicitly to the code
+// under test with slog.New(rec).
+package logrecord
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ilius/demand/check"
+	"github.com/ilius/demand/require"
+)
+
+// Record is a recorded log record, with the attributes of the logger and
+// of the record, where the keys of attributes in groups are prefixed with
+// the group names, like "request.method".
+type Record struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	Attrs   []slog.Attr
+}
+
+// String returns the level, message and attributes of the record, like
+// `WARN user not found name=alice`.
+func (r *Record) String() string {
+	var sb strings.Builder
+	sb.WriteString(r.Level.String())
+	sb.WriteByte(' ')
+	sb.WriteString(r.Message)
+	for _, attr := range r.Attrs {
+		fmt.Fprintf(&sb, " %s=%v", attr.Key, attr.Value)
+	}
+	return sb.String()
+}
+
+// Attr returns the value of the attribute with the given key (prefixed
+// with its group names, like "request.method"), and whether it was found.
+// If the key is repeated, the last value is returned.
+func (r *Record) Attr(key string) (any, bool) {
+	for i := len(r.Attrs) - 1; i >= 0; i-- {
+		if r.Attrs[i].Key == key {
+			return r.Attrs[i].Value.Any(), true
+		}
+	}
+	return nil, false
+}
+
+// Recorder is a slog.Handler that records all log records, at any level.
+// It is safe for concurrent use.
+type Recorder struct {
+	state *recorderState
+
+	attrs  []slog.Attr
+	groups []string
+}
+
+type recorderState struct {
+	mu      sync.Mutex
+	records []*Record
+}
+
+// New returns an empty Recorder.
+func New() *Recorder {
+	return &Recorder{state: &recorderState{}}
+}
+
+// Capture returns a new Recorder, and makes it the handler of the default
+// slog.Logger (which also receives the output of the log package functions,
+// at slog.LevelInfo) until the end of the test.
+// Since the default logger is global, Capture must not be used in parallel
+// tests.
+func Capture(t require.TestingT) *Recorder {
+	rec := New()
+	origLogger := slog.Default()
+	origWriter := log.Writer()
+	origFlags := log.Flags()
+	slog.SetDefault(slog.New(rec))
+	t.Cleanup(func() {
+		slog.SetDefault(origLogger)
+		// slog.SetDefault redirects the log package to the handler, and
+		// does not undo it when given back the original logger.
+		log.SetOutput(origWriter)
+		log.SetFlags(origFlags)
+	})
+	return rec
+}
+
+// Enabled returns true for all levels.
+func (rec *Recorder) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+// prefix returns the prefix of keys for the current groups.
+func (rec *Recorder) prefix() string {
+	if len(rec.groups) == 0 {
+		return ""
+	}
+	return strings.Join(rec.groups, ".") + "."
+}
+
+// flattenAttr appends attr to attrs, with key prefixed by prefix, expanding
+// group values.
+func flattenAttr(attrs []slog.Attr, prefix string, attr slog.Attr) []slog.Attr {
+	value := attr.Value.Resolve()
+	if value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if attr.Key != "" {
+			groupPrefix += attr.Key + "."
+		}
+		for _, member := range value.Group() {
+			attrs = flattenAttr(attrs, groupPrefix, member)
+		}
+		return attrs
+	}
+	if attr.Key == "" {
+		return attrs
+	}
+	return append(attrs, slog.Attr{Key: prefix + attr.Key, Value: value})
+}
+
+// Handle records the record.
+func (rec *Recorder) Handle(_ context.Context, r slog.Record) error {
+	recorded := &Record{
+		Time:    r.Time,
+		Level:   r.Level,
+		Message: r.Message,
+		Attrs:   append([]slog.Attr{}, rec.attrs...),
+	}
+	prefix := rec.prefix()
+	r.Attrs(func(attr slog.Attr) bool {
+		recorded.Attrs = flattenAttr(recorded.Attrs, prefix, attr)
+		return true
+	})
+	rec.state.mu.Lock()
+	rec.state.records = append(rec.state.records, recorded)
+	rec.state.mu.Unlock()
+	return nil
+}
+
+// WithAttrs returns a handler recording to the same Recorder, adding attrs
+// to the records.
+func (rec *Recorder) WithAttrs(attrs []slog.Attr) slog.Handler {
+	clone := *rec
+	clone.attrs = append([]slog.Attr{}, rec.attrs...)
+	prefix := rec.prefix()
+	for _, attr := range attrs {
+		clone.attrs = flattenAttr(clone.attrs, prefix, attr)
+	}
+	return &clone
+}
+
+// WithGroup returns a handler recording to the same Recorder, prefixing
+// the keys of the attributes that follow with the group name.
+func (rec *Recorder) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return rec
+	}
+	clone := *rec
+	clone.groups = append(append([]string{}, rec.groups...), name)
+	return &clone
+}
+
+// Records returns the recorded records.
+func (rec *Recorder) Records() []*Record {
+	rec.state.mu.Lock()
+	defer rec.state.mu.Unlock()
+	return append([]*Record{}, rec.state.records...)
+}
+
+// Reset forgets the recorded records.
+func (rec *Recorder) Reset() {
+	rec.state.mu.Lock()
+	defer rec.state.mu.Unlock()
+	rec.state.records = nil
+}
+
+// atLevel returns the records at level or above.
+func (rec *Recorder) atLevel(level slog.Level) []*Record {
+	var records []*Record
+	for _, r := range rec.Records() {
+		if r.Level >= level {
+			records = append(records, r)
+		}
+	}
+	return records
+}
+
+// formatRecords lists the records for failure messages.
+func formatRecords(records []*Record) string {
+	if len(records) == 0 {
+		return "no records"
+	}
+	lines := make([]string, len(records))
+	for i, r := range records {
+		lines[i] = "\t" + r.String()
+	}
+	return "records:\n" + strings.Join(lines, "\n")
+}
+
+// LogContains asserts that a record at level or above was recorded, whose
+// message or attributes (as formatted by Record.String) contain substr.
+func (rec *Recorder) LogContains(t require.TestingT, level slog.Level, substr string, msgAndArgs ...any) {
+	t.Helper()
+	for _, r := range rec.atLevel(level) {
+		if strings.Contains(r.Message, substr) || strings.Contains(r.String(), substr) {
+			return
+		}
+	}
+	require.Fail(t, fmt.Sprintf("logrecord: expected a record at level %s or above containing %q, %s", level, substr, formatRecords(rec.Records())), msgAndArgs...)
+}
+
+// LogCount asserts that n records at level or above were recorded.
+func (rec *Recorder) LogCount(t require.TestingT, level slog.Level, n int, msgAndArgs ...any) {
+	t.Helper()
+	records := rec.atLevel(level)
+	if len(records) != n {
+		require.Fail(t, fmt.Sprintf("logrecord: expected %d records at level %s or above, but got %d, %s", n, level, len(records), formatRecords(records)), msgAndArgs...)
+	}
+}
+
+// NoLogsAtLevel asserts that no records at level or above were recorded,
+// for example no errors with slog.LevelError.
+func (rec *Recorder) NoLogsAtLevel(t require.TestingT, level slog.Level, msgAndArgs ...any) {
+	t.Helper()
+	records := rec.atLevel(level)
+	if len(records) > 0 {
+		require.Fail(t, fmt.Sprintf("logrecord: expected no records at level %s or above, %s", level, formatRecords(records)), msgAndArgs...)
+	}
+}
+
+// LogRecordHasAttr asserts that the record has the attribute key (prefixed
+// with its group names, like "request.method") with the given value.
+// Values of different numeric types are compared after conversion, so
+// 42 matches an attribute added with slog.Int64("user_id", 42).
+func LogRecordHasAttr(t require.TestingT, r *Record, key string, value any, msgAndArgs ...any) {
+	t.Helper()
+	actual, ok := r.Attr(key)
+	if !ok {
+		require.Fail(t, fmt.Sprintf("logrecord: record has no attribute %q: %s", key, r), msgAndArgs...)
+		return
+	}
+	if check.Equal(value, actual) != nil {
+		require.Fail(t, fmt.Sprintf("logrecord: attribute %q mismatch:\nexpected: %#v\nactual  : %#v\nrecord: %s", key, value, actual, r), msgAndArgs...)
+	}
+}
+
+// recordMismatch returns why the record does not match the partial
+// attribute map expected, or "" if it matches.
+func recordMismatch(r *Record, expected map[string]any) string {
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := expected[key]
+		var actual any
+		switch key {
+		case slog.MessageKey:
+			actual = r.Message
+		case slog.LevelKey:
+			actual = r.Level.String()
+			if level, ok := value.(slog.Level); ok {
+				value = level.String()
+			}
+		default:
+			var ok bool
+			actual, ok = r.Attr(key)
+			if !ok {
+				return fmt.Sprintf("no attribute %q", key)
+			}
+		}
+		if check.Equal(value, actual) != nil {
+			return fmt.Sprintf("%q: expected %#v, actual %#v", key, value, actual)
+		}
+	}
+	return ""
+}
+
+// LogRecordsMatch asserts that there are as many records as expected
+// partial attribute maps, and that each record has the attributes of the
+// corresponding map. The keys slog.MessageKey ("msg") and slog.LevelKey
+// ("level") match the message and level of the record (given as a
+// slog.Level or its string form, like "WARN"), rather than attributes.
+//
+//	logrecord.LogRecordsMatch(t, rec.Records(), []map[string]any{
+//		{"msg": "user created", "user_id": 42},
+//		{"level": slog.LevelWarn, "quota.remaining": 0},
+//	})
+func LogRecordsMatch(t require.TestingT, records []*Record, expected []map[string]any, msgAndArgs ...any) {
+	t.Helper()
+	if len(records) != len(expected) {
+		require.Fail(t, fmt.Sprintf("logrecord: expected %d records, but got %d, %s", len(expected), len(records), formatRecords(records)), msgAndArgs...)
+		return
+	}
+	var mismatches []string
+	for i, r := range records {
+		if mismatch := recordMismatch(r, expected[i]); mismatch != "" {
+			mismatches = append(mismatches, fmt.Sprintf("\trecord %d (%s): %s", i, r, mismatch))
+		}
+	}
+	if len(mismatches) > 0 {
+		require.Fail(t, "logrecord: records do not match:\n"+strings.Join(mismatches, "\n"), msgAndArgs...)
+	}
+}
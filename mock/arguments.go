@@ -0,0 +1,195 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Anything is used in expectations to match any value of an argument.
+const Anything = "mock.Anything"
+
+// Arguments holds an array of method arguments or return values.
+type Arguments []any
+
+// Get returns the argument at the specified index.
+func (args Arguments) Get(index int) any {
+	if index+1 > len(args) {
+		panic(fmt.Sprintf("mock: cannot call Get(%d) because there are only %d argument(s)", index, len(args)))
+	}
+	return args[index]
+}
+
+// String returns the argument at the specified index as a string.
+// It panics if the argument is not a string.
+func (args Arguments) String(index int) string {
+	s, ok := args.Get(index).(string)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not string", index, args.Get(index)))
+	}
+	return s
+}
+
+// Int returns the argument at the specified index as an int.
+// It panics if the argument is not an int.
+func (args Arguments) Int(index int) int {
+	v, ok := args.Get(index).(int)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not int", index, args.Get(index)))
+	}
+	return v
+}
+
+// Bool returns the argument at the specified index as a bool.
+// It panics if the argument is not a bool.
+func (args Arguments) Bool(index int) bool {
+	v, ok := args.Get(index).(bool)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not bool", index, args.Get(index)))
+	}
+	return v
+}
+
+// Error returns the argument at the specified index as an error.
+// It returns nil if the argument is nil, and panics if it is not an error.
+func (args Arguments) Error(index int) error {
+	obj := args.Get(index)
+	if obj == nil {
+		return nil
+	}
+	v, ok := obj.(error)
+	if !ok {
+		panic(fmt.Sprintf("mock: argument %d is %T, not error", index, obj))
+	}
+	return v
+}
+
+// Diff compares the expected arguments to the actual objects, and returns
+// a description of the differences and the number of differences.
+func (args Arguments) Diff(objects []any) (string, int) {
+	var lines []string
+	count := 0
+	maxLen := len(args)
+	if len(objects) > maxLen {
+		maxLen = len(objects)
+	}
+	for i := 0; i < maxLen; i++ {
+		var expected, actual any
+		expectedStr, actualStr := "(Missing)", "(Missing)"
+		if i < len(args) {
+			expected = args[i]
+			expectedStr = fmt.Sprintf("(%T=%v)", expected, expected)
+		}
+		if i < len(objects) {
+			actual = objects[i]
+			actualStr = fmt.Sprintf("(%T=%v)", actual, actual)
+		}
+		if i >= len(args) || i >= len(objects) {
+			count++
+			lines = append(lines, fmt.Sprintf("\t%d: FAIL:  %s != %s", i, actualStr, expectedStr))
+			continue
+		}
+		if !argumentMatches(expected, actual) {
+			count++
+			lines = append(lines, fmt.Sprintf("\t%d: FAIL:  %s != %s", i, actualStr, expectedStr))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("\t%d: PASS:  %s == %s", i, actualStr, expectedStr))
+	}
+	return strings.Join(lines, "\n"), count
+}
+
+func (args Arguments) matches(objects []any) bool {
+	_, count := args.Diff(objects)
+	return count == 0
+}
+
+func argumentMatches(expected any, actual any) bool {
+	switch e := expected.(type) {
+	case argumentMatcher:
+		return e.matches(actual)
+	case anythingOfTypeArgument:
+		if actual == nil {
+			return false
+		}
+		return string(e) == fmt.Sprintf("%T", actual) ||
+			string(e) == reflect.TypeOf(actual).Name()
+	}
+	if expected == Anything {
+		return true
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+type anythingOfTypeArgument string
+
+// AnythingOfType returns a matcher for any argument of the given type, for
+// example AnythingOfType("*http.Request") or AnythingOfType("string").
+func AnythingOfType(typeName string) any {
+	return anythingOfTypeArgument(typeName)
+}
+
+type argumentMatcher struct {
+	fn reflect.Value
+}
+
+func (m argumentMatcher) matches(actual any) bool {
+	expectedType := m.fn.Type().In(0)
+	if actual == nil {
+		switch expectedType.Kind() {
+		case reflect.Interface, reflect.Chan, reflect.Func,
+			reflect.Map, reflect.Ptr, reflect.Slice:
+			return m.fn.Call([]reflect.Value{reflect.Zero(expectedType)})[0].Bool()
+		}
+		return false
+	}
+	actualValue := reflect.ValueOf(actual)
+	if !actualValue.Type().AssignableTo(expectedType) {
+		return false
+	}
+	return m.fn.Call([]reflect.Value{actualValue})[0].Bool()
+}
+
+func (m argumentMatcher) String() string {
+	return fmt.Sprintf("MatchedBy(%s)", m.fn.Type().In(0))
+}
+
+// MatchedBy returns a matcher for arguments that satisfy fn, which must be
+// a function with a single argument returning bool, for example:
+//
+//	m.On("Save", mock.MatchedBy(func(u *User) bool { return u.ID > 0 }))
+//
+// Arguments that are not assignable to the argument type of fn do not match.
+func MatchedBy(fn any) any {
+	fnType := reflect.TypeOf(fn)
+	if fnType == nil || fnType.Kind() != reflect.Func {
+		panic(fmt.Sprintf("mock: MatchedBy requires a function, got %T", fn))
+	}
+	if fnType.NumIn() != 1 || fnType.NumOut() != 1 || fnType.Out(0).Kind() != reflect.Bool {
+		panic(fmt.Sprintf("mock: MatchedBy requires func(T) bool, got %s", fnType))
+	}
+	return argumentMatcher{fn: reflect.ValueOf(fn)}
+}
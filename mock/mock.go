@@ -0,0 +1,388 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package mock provides a system by which it is possible to mock your
+// objects and verify calls are happening as expected, similar to
+// github.com/stretchr/testify/mock.
+//
+// A mock is created by embedding Mock in a struct:
+//
+//	type MockStore struct {
+//		mock.Mock
+//	}
+//
+//	func (m *MockStore) Get(key string) (string, error) {
+//		args := m.Called(key)
+//		return args.String(0), args.Error(1)
+//	}
+//
+// and setting expectations in the test:
+//
+//	store := &MockStore{}
+//	store.Test(t)
+//	store.On("Get", "a").Return("1", nil).Once()
+//	// ...
+//	store.AssertExpectations(t)
+package mock
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/ilius/demand/require"
+)
+
+// Call represents a method call and is used for setting expectations,
+// as well as recording activity.
+type Call struct {
+	Parent *Mock
+
+	// The name of the method that was or will be called.
+	Method string
+
+	// Holds the arguments of the method.
+	Arguments Arguments
+
+	// Holds the arguments that should be returned when
+	// this method is called.
+	ReturnArguments Arguments
+
+	// The number of times to return the return arguments when setting
+	// expectations. 0 means to always return the value.
+	Repeatability int
+
+	// Amount of times this call has been called
+	totalCalls int
+
+	// Holds a handler used to manipulate arguments content that are passed by
+	// reference.
+	RunFn func(Arguments)
+
+	// optional calls are not required by AssertExpectations
+	optional bool
+}
+
+// Return specifies the return arguments for the expectation.
+//
+//	m.On("DoSomething").Return(errors.New("failed"))
+func (c *Call) Return(returnArguments ...any) *Call {
+	c.Parent.mutex.Lock()
+	defer c.Parent.mutex.Unlock()
+	c.ReturnArguments = returnArguments
+	return c
+}
+
+// Once indicates that the mock should only return the value once.
+func (c *Call) Once() *Call {
+	return c.Times(1)
+}
+
+// Twice indicates that the mock should only return the value twice.
+func (c *Call) Twice() *Call {
+	return c.Times(2)
+}
+
+// Times indicates that the mock should only return the indicated number
+// of times, and that AssertExpectations requires exactly that many calls.
+func (c *Call) Times(i int) *Call {
+	c.Parent.mutex.Lock()
+	defer c.Parent.mutex.Unlock()
+	c.Repeatability = i
+	return c
+}
+
+// Maybe allows the method call to be optional. Not calling an optional
+// method will not cause an error while asserting expectations.
+func (c *Call) Maybe() *Call {
+	c.Parent.mutex.Lock()
+	defer c.Parent.mutex.Unlock()
+	c.optional = true
+	return c
+}
+
+// Run sets a handler to be called before returning. It can be used when
+// mocking a method (such as an unmarshaler) that takes a pointer to a
+// struct and sets properties in such struct.
+func (c *Call) Run(fn func(args Arguments)) *Call {
+	c.Parent.mutex.Lock()
+	defer c.Parent.mutex.Unlock()
+	c.RunFn = fn
+	return c
+}
+
+// On chains a new expectation description onto the mocked interface.
+//
+//	m.On("A").Return(1).On("B", "x").Return(2)
+func (c *Call) On(methodName string, arguments ...any) *Call {
+	return c.Parent.On(methodName, arguments...)
+}
+
+func (c *Call) String() string {
+	return fmt.Sprintf("%s(%s)", c.Method, formatArgs(c.Arguments))
+}
+
+// Mock is the workhorse used to track activity on another object.
+// For an example of its usage, refer to the package documentation.
+type Mock struct {
+	// Represents the calls that are expected of an object.
+	ExpectedCalls []*Call
+
+	// Holds the calls that were made to this mocked object.
+	Calls []Call
+
+	// test is used for reporting unexpected calls, instead of panicking
+	test require.TestingT
+
+	mutex sync.Mutex
+}
+
+// Test sets the test to report unexpected calls to.
+// Without it, unexpected calls cause a panic.
+func (m *Mock) Test(t require.TestingT) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.test = t
+}
+
+// On starts a description of an expectation of the specified method
+// being called.
+//
+//	m.On("MyMethod", arg1, arg2)
+func (m *Mock) On(methodName string, arguments ...any) *Call {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	c := &Call{
+		Parent:    m,
+		Method:    methodName,
+		Arguments: arguments,
+	}
+	m.ExpectedCalls = append(m.ExpectedCalls, c)
+	return c
+}
+
+var methodNameRe = regexp.MustCompile(`\.([^.\[\]]+)(\[[^\]]*\])?$`)
+
+// Called tells the mock object that a method has been called, and gets an
+// array of arguments to return. The method name is taken from the caller.
+func (m *Mock) Called(arguments ...any) Arguments {
+	pc, _, _, ok := runtime.Caller(1)
+	if !ok {
+		panic("mock: couldn't get the caller information")
+	}
+	funcName := runtime.FuncForPC(pc).Name()
+	match := methodNameRe.FindStringSubmatch(funcName)
+	if match == nil {
+		panic(fmt.Sprintf("mock: couldn't get the method name from %q", funcName))
+	}
+	return m.MethodCalled(match[1], arguments...)
+}
+
+// MethodCalled tells the mock object that the given method has been called,
+// and gets an array of arguments to return.
+func (m *Mock) MethodCalled(methodName string, arguments ...any) Arguments {
+	m.mutex.Lock()
+	call, failure := m.findExpectedCall(methodName, arguments)
+	if call == nil {
+		t := m.test
+		m.mutex.Unlock()
+		if t == nil {
+			panic(failure)
+		}
+		require.Fail(t, failure)
+		return nil
+	}
+	call.totalCalls++
+	m.Calls = append(m.Calls, Call{
+		Parent:          m,
+		Method:          methodName,
+		Arguments:       arguments,
+		ReturnArguments: call.ReturnArguments,
+	})
+	runFn := call.RunFn
+	returnArguments := call.ReturnArguments
+	m.mutex.Unlock()
+
+	if runFn != nil {
+		runFn(arguments)
+	}
+	return returnArguments
+}
+
+// findExpectedCall returns the first expectation of the method that matches
+// the arguments and has not been used up, or a failure message if there is
+// no such expectation.
+func (m *Mock) findExpectedCall(methodName string, arguments []any) (*Call, string) {
+	var exhausted *Call
+	var closest *Call
+	for _, call := range m.ExpectedCalls {
+		if call.Method != methodName {
+			continue
+		}
+		if !call.Arguments.matches(arguments) {
+			if closest == nil {
+				closest = call
+			}
+			continue
+		}
+		if call.Repeatability > 0 && call.totalCalls >= call.Repeatability {
+			exhausted = call
+			continue
+		}
+		return call, ""
+	}
+	call := fmt.Sprintf("%s(%s)", methodName, formatArgs(arguments))
+	if exhausted != nil {
+		return nil, fmt.Sprintf(
+			"mock: the call %s was made more than the expected %d time(s)",
+			call, exhausted.Repeatability,
+		)
+	}
+	if closest != nil {
+		diff, _ := closest.Arguments.Diff(arguments)
+		return nil, fmt.Sprintf(
+			"mock: unexpected call %s\n\nthe closest call I have is %s\n%s",
+			call, closest, diff,
+		)
+	}
+	return nil, fmt.Sprintf(
+		"mock: unexpected call %s\n\neither do m.On(%q).Return(...) first, or remove the call",
+		call, methodName,
+	)
+}
+
+// AssertExpectations asserts that everything specified with On and Return
+// was in fact called as expected. Calls may have occurred in any order.
+func (m *Mock) AssertExpectations(t require.TestingT, msgAndArgs ...any) {
+	t.Helper()
+	m.mutex.Lock()
+	var failures []string
+	for _, call := range m.ExpectedCalls {
+		switch {
+		case call.Repeatability > 0 && call.totalCalls != call.Repeatability:
+			failures = append(failures, fmt.Sprintf(
+				"\tFAIL:\t%s: expected %d call(s), got %d",
+				call, call.Repeatability, call.totalCalls,
+			))
+		case call.totalCalls == 0 && !call.optional:
+			failures = append(failures, fmt.Sprintf("\tFAIL:\t%s: not called", call))
+		}
+	}
+	total := len(m.ExpectedCalls)
+	m.mutex.Unlock()
+	if len(failures) == 0 {
+		return
+	}
+	require.Fail(t, fmt.Sprintf(
+		"mock: %d of %d expectation(s) were not met:\n%s",
+		len(failures), total, strings.Join(failures, "\n"),
+	), msgAndArgs...)
+}
+
+// AssertCalled asserts that the method was called with the given arguments.
+func (m *Mock) AssertCalled(t require.TestingT, methodName string, arguments ...any) {
+	t.Helper()
+	m.mutex.Lock()
+	var calls []string
+	for _, call := range m.Calls {
+		if call.Method != methodName {
+			continue
+		}
+		if Arguments(arguments).matches(call.Arguments) {
+			m.mutex.Unlock()
+			return
+		}
+		calls = append(calls, call.String())
+	}
+	m.mutex.Unlock()
+	require.Fail(t, fmt.Sprintf(
+		"mock: expected %s(%s) to have been called, actual calls of %s: %v",
+		methodName, formatArgs(arguments), methodName, calls,
+	))
+}
+
+// AssertNotCalled asserts that the method was not called with the given
+// arguments.
+func (m *Mock) AssertNotCalled(t require.TestingT, methodName string, arguments ...any) {
+	t.Helper()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, call := range m.Calls {
+		if call.Method == methodName && Arguments(arguments).matches(call.Arguments) {
+			require.Fail(t, fmt.Sprintf("mock: expected %s not to have been called", call.String()))
+			return
+		}
+	}
+}
+
+// AssertNumberOfCalls asserts that the method was called expectedCalls times.
+func (m *Mock) AssertNumberOfCalls(t require.TestingT, methodName string, expectedCalls int) {
+	t.Helper()
+	m.mutex.Lock()
+	actualCalls := 0
+	for _, call := range m.Calls {
+		if call.Method == methodName {
+			actualCalls++
+		}
+	}
+	m.mutex.Unlock()
+	if actualCalls != expectedCalls {
+		require.Fail(t, fmt.Sprintf(
+			"mock: expected %s to be called %d time(s), but it was called %d time(s)",
+			methodName, expectedCalls, actualCalls,
+		))
+	}
+}
+
+// AssertExpectationsForObjects asserts that everything specified with On
+// and Return of the given mocks was in fact called as expected.
+func AssertExpectationsForObjects(t require.TestingT, testObjects ...interface {
+	AssertExpectations(t require.TestingT, msgAndArgs ...any)
+},
+) {
+	t.Helper()
+	for _, obj := range testObjects {
+		obj.AssertExpectations(t)
+	}
+}
+
+func formatArgs(args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		switch a := arg.(type) {
+		case argumentMatcher:
+			parts[i] = a.String()
+		case anythingOfTypeArgument:
+			parts[i] = fmt.Sprintf("AnythingOfType(%q)", string(a))
+		default:
+			if arg == Anything {
+				parts[i] = Anything
+				continue
+			}
+			parts[i] = fmt.Sprintf("%#v", arg)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
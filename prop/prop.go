@@ -0,0 +1,130 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package prop provides simple property-based testing on top of the
+// require package, using testing/quick for generating values.
+//
+// There is no shrinking of failing values, but the random seed is reported
+// on failure, and the same values can be generated again by passing that
+// seed with -demand.seed flag of go test.
+package prop
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing/quick"
+	"time"
+
+	"github.com/ilius/demand/require"
+)
+
+var seedFlag = flag.Int64("demand.seed", 0, "random seed for property tests of demand/prop, 0 means a new seed for each run")
+
+const defaultMaxCount = 100
+
+// Generator generates a random value of type T.
+type Generator[T any] func(r *rand.Rand) T
+
+// ForAll calls f with random values of type T, and fails the test on the
+// first value for which f has a failed assertion, reporting the value,
+// the iteration and the seed to replay the same values with.
+//
+// gen can be:
+//   - nil: values are generated by quick.Value, 100 times
+//   - Generator[T] or func(*rand.Rand) T: values are generated by gen, 100 times
+//   - *quick.Config: values are generated by its Values function, or by
+//     quick.Value if Values is nil, MaxCount (or MaxCountScale) times.
+//     Rand of the config is ignored, so that the seed can be reported.
+//
+// f must use the TestingT it receives (not the one of the test) for
+// assertions.
+func ForAll[T any](t require.TestingT, gen any, f func(t require.TestingT, v T)) {
+	t.Helper()
+	generate, count := generator[T](gen)
+	seed := *seedFlag
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	r := rand.New(rand.NewSource(seed))
+	for i := 0; i < count; i++ {
+		v, ok := generate(r)
+		if !ok {
+			var zero T
+			require.Fail(t, fmt.Sprintf("prop: can not generate values of type %T", zero))
+			return
+		}
+		rec := &recorder{TB: t}
+		rec.run(func() {
+			f(rec, v)
+		})
+		if !rec.failed {
+			continue
+		}
+		require.Fail(t, fmt.Sprintf(
+			"property failed on iteration %d (seed %d) for value: %#v\n%s\nreplay with: go test -run '%s' -demand.seed=%d",
+			i, seed, v, strings.Join(rec.errors, "\n"), t.Name(), seed,
+		))
+		return
+	}
+}
+
+func generator[T any](gen any) (func(r *rand.Rand) (T, bool), int) {
+	switch g := gen.(type) {
+	case nil:
+		return quickValue[T](nil), defaultMaxCount
+	case Generator[T]:
+		return func(r *rand.Rand) (T, bool) { return g(r), true }, defaultMaxCount
+	case func(*rand.Rand) T:
+		return func(r *rand.Rand) (T, bool) { return g(r), true }, defaultMaxCount
+	case *quick.Config:
+		count := defaultMaxCount
+		switch {
+		case g.MaxCount > 0:
+			count = g.MaxCount
+		case g.MaxCountScale > 0:
+			count = int(g.MaxCountScale * float64(defaultMaxCount))
+		}
+		return quickValue[T](g.Values), count
+	}
+	panic(fmt.Sprintf("prop: unsupported generator type %T", gen))
+}
+
+func quickValue[T any](values func([]reflect.Value, *rand.Rand)) func(r *rand.Rand) (T, bool) {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	return func(r *rand.Rand) (T, bool) {
+		var v T
+		if values != nil {
+			args := []reflect.Value{reflect.New(typ).Elem()}
+			values(args, r)
+			return args[0].Interface().(T), true
+		}
+		value, ok := quick.Value(typ, r)
+		if !ok {
+			return v, false
+		}
+		return value.Interface().(T), true
+	}
+}
@@ -0,0 +1,267 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package protoassert provides equality assertions for protobuf messages,
+// which must not be compared with reflect.DeepEqual (and so, with
+// require.Equal), since generated messages have internal state.
+// Messages are compared with proto.Equal, and the differences are
+// reported by field path:
+//
+//	protoassert.ProtoEqual(t, want, got)
+//	protoassert.ProtoEqualWith(t, want, got, protoassert.Options{
+//		IgnoreFields: []string{"update_time"},
+//	})
+package protoassert
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/ilius/demand/require"
+)
+
+// Options configures the comparison of ProtoEqualWith.
+type Options struct {
+	// IgnoreFields are the names of fields (as in the .proto file) that are
+	// not compared, at any level of nesting. A name can be qualified by the
+	// full name of the message, like "example.User.update_time", to ignore
+	// the field only in that message.
+	IgnoreFields []string
+	// IgnoreFieldNumbers are the numbers of fields that are not compared,
+	// at any level of nesting.
+	IgnoreFieldNumbers []protoreflect.FieldNumber
+}
+
+// ignores reports if field is ignored by opts.
+func (opts *Options) ignores(field protoreflect.FieldDescriptor) bool {
+	for _, name := range opts.IgnoreFields {
+		if name == string(field.Name()) || name == string(field.FullName()) {
+			return true
+		}
+	}
+	for _, number := range opts.IgnoreFieldNumbers {
+		if number == field.Number() {
+			return true
+		}
+	}
+	return false
+}
+
+// ProtoEqual asserts that the protobuf messages are equal, see proto.Equal.
+func ProtoEqual(t require.TestingT, expected proto.Message, actual proto.Message, msgAndArgs ...any) {
+	t.Helper()
+	ProtoEqualWith(t, expected, actual, Options{}, msgAndArgs...)
+}
+
+// ProtoEqualWith is like ProtoEqual, but ignores the fields given by opts.
+func ProtoEqualWith(t require.TestingT, expected proto.Message, actual proto.Message, opts Options, msgAndArgs ...any) {
+	t.Helper()
+	if expected == nil || actual == nil {
+		if expected != actual {
+			require.Fail(t, fmt.Sprintf("proto messages are not equal:\nexpected: %v\nactual  : %v", expected, actual), msgAndArgs...)
+		}
+		return
+	}
+	expectedName := expected.ProtoReflect().Descriptor().FullName()
+	actualName := actual.ProtoReflect().Descriptor().FullName()
+	if expectedName != actualName {
+		require.Fail(t, fmt.Sprintf("proto message types are not equal:\nexpected: %s\nactual  : %s", expectedName, actualName), msgAndArgs...)
+		return
+	}
+	if len(opts.IgnoreFields)+len(opts.IgnoreFieldNumbers) > 0 {
+		expected = proto.Clone(expected)
+		actual = proto.Clone(actual)
+		clearFields(expected.ProtoReflect(), &opts)
+		clearFields(actual.ProtoReflect(), &opts)
+	}
+	if proto.Equal(expected, actual) {
+		return
+	}
+	d := &differ{}
+	d.messages("", expected.ProtoReflect(), actual.ProtoReflect())
+	if len(d.lines) == 0 {
+		// for example different unknown fields
+		d.lines = append(d.lines, fmt.Sprintf("%s: messages differ in unknown fields or extensions", expectedName))
+	}
+	require.Fail(t, fmt.Sprintf("proto messages are not equal:\n\t%s", strings.Join(d.lines, "\n\t")), msgAndArgs...)
+}
+
+// clearFields clears the fields of msg ignored by opts, recursively.
+func clearFields(msg protoreflect.Message, opts *Options) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if opts.ignores(field) {
+			msg.Clear(field)
+			return true
+		}
+		switch {
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				clearFields(list.Get(i).Message(), opts)
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				clearFields(v.Message(), opts)
+				return true
+			})
+		case !field.IsList() && !field.IsMap() && field.Message() != nil:
+			clearFields(value.Message(), opts)
+		}
+		return true
+	})
+}
+
+// differ collects the differences of messages by field path.
+type differ struct {
+	lines []string
+}
+
+func (d *differ) addf(path string, format string, args ...any) {
+	d.lines = append(d.lines, path+": "+fmt.Sprintf(format, args...))
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func (d *differ) messages(path string, expected protoreflect.Message, actual protoreflect.Message) {
+	fields := expected.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		fieldPath := joinPath(path, string(field.Name()))
+		expectedHas, actualHas := expected.Has(field), actual.Has(field)
+		if !expectedHas && !actualHas {
+			continue
+		}
+		if expectedHas != actualHas && (field.HasPresence() || field.Message() != nil) {
+			d.addf(fieldPath, "expected %s, actual %s", presence(expected, field), presence(actual, field))
+			continue
+		}
+		switch {
+		case field.IsList():
+			d.lists(fieldPath, field, expected.Get(field).List(), actual.Get(field).List())
+		case field.IsMap():
+			d.maps(fieldPath, field, expected.Get(field).Map(), actual.Get(field).Map())
+		default:
+			d.values(fieldPath, field, expected.Get(field), actual.Get(field))
+		}
+	}
+}
+
+// presence describes if the field is set in msg, with its value.
+func presence(msg protoreflect.Message, field protoreflect.FieldDescriptor) string {
+	if !msg.Has(field) {
+		return "unset"
+	}
+	if field.Message() != nil {
+		return "set"
+	}
+	return formatValue(field, msg.Get(field))
+}
+
+func (d *differ) lists(path string, field protoreflect.FieldDescriptor, expected protoreflect.List, actual protoreflect.List) {
+	if expected.Len() != actual.Len() {
+		d.addf(path, "expected %d elements, actual %d elements", expected.Len(), actual.Len())
+	}
+	n := expected.Len()
+	if actual.Len() < n {
+		n = actual.Len()
+	}
+	for i := 0; i < n; i++ {
+		d.values(fmt.Sprintf("%s[%d]", path, i), field, expected.Get(i), actual.Get(i))
+	}
+}
+
+func (d *differ) maps(path string, field protoreflect.FieldDescriptor, expected protoreflect.Map, actual protoreflect.Map) {
+	keys := map[string]protoreflect.MapKey{}
+	collect := func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[fmt.Sprintf("%q", key.String())] = key
+		return true
+	}
+	expected.Range(collect)
+	actual.Range(collect)
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	valueField := field.MapValue()
+	for _, k := range sorted {
+		key := keys[k]
+		keyPath := fmt.Sprintf("%s[%s]", path, k)
+		switch {
+		case !expected.Has(key):
+			d.addf(keyPath, "unexpected key")
+		case !actual.Has(key):
+			d.addf(keyPath, "missing key")
+		default:
+			d.values(keyPath, valueField, expected.Get(key), actual.Get(key))
+		}
+	}
+}
+
+func (d *differ) values(path string, field protoreflect.FieldDescriptor, expected protoreflect.Value, actual protoreflect.Value) {
+	if field.Message() != nil {
+		d.messages(path, expected.Message(), actual.Message())
+		return
+	}
+	if !scalarEqual(field, expected, actual) {
+		d.addf(path, "expected %s, actual %s", formatValue(field, expected), formatValue(field, actual))
+	}
+}
+
+func scalarEqual(field protoreflect.FieldDescriptor, expected protoreflect.Value, actual protoreflect.Value) bool {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		return bytes.Equal(expected.Bytes(), actual.Bytes())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		// like proto.Equal, NaN values are not equal
+		e, a := expected.Float(), actual.Float()
+		return e == a && !math.IsNaN(e)
+	}
+	return expected.Interface() == actual.Interface()
+}
+
+func formatValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%q", value.String())
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%q", value.Bytes())
+	case protoreflect.EnumKind:
+		if enumValue := field.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return fmt.Sprint(value.Enum())
+	}
+	return fmt.Sprint(value.Interface())
+}
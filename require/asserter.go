@@ -0,0 +1,271 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ilius/demand/internal/core"
+)
+
+// Equaler is used to define equality for types, in assertions such as
+// Equal. For example, a struct that includes time.Time fields can implement
+// it using time.Time.Equal() for the comparison.
+type Equaler interface {
+	Equal(in any) bool
+}
+
+// asserter reports the failures of an assertion to the test, followed by
+// the optional message of the assertion. The message is only formatted on
+// failure, so passing assertions do not allocate.
+type asserter struct {
+	t          TestingT
+	msgAndArgs []any
+}
+
+// newAsserter creates an asserter for the given test and msgAndArgs, which
+// is either empty, or a format string followed by its arguments.
+func newAsserter(t TestingT, msgAndArgs []any) *asserter {
+	if t == nil {
+		panic("You must provide a testing object.")
+	}
+	return &asserter{
+		t:          t,
+		msgAndArgs: msgAndArgs,
+	}
+}
+
+// Fail fails the test with given message, and stops it.
+func (a *asserter) Fail(msg string) {
+	a.t.Helper()
+	if userMsg := core.FormatMsgAndArgs(a.msgAndArgs); userMsg != "" {
+		msg += " - " + userMsg
+	}
+	if _, ok := a.t.(core.Recorder); !ok {
+		if code := failureCode(1); code != "" {
+			msg += "\n" + code
+		}
+		if info := callerInfo(1); info != "" {
+			msg += "\n" + info
+		}
+	}
+	if stackMode != StackUser {
+		msg += "\nStack:\n" + stackTrace(1)
+	}
+	a.t.Fatal(msg)
+}
+
+func (a *asserter) failf(format string, args ...any) {
+	a.t.Helper()
+	a.Fail(fmt.Sprintf(format, args...))
+}
+
+// recordValues gives the expected and actual values of a failing equality
+// assertion to the span of the test, if any, see WithSpan.
+func (a *asserter) recordValues(expected any, actual any) {
+	if s := findSpan(a.t); s != nil {
+		s.setValues(expected, actual)
+	}
+}
+
+// Equal fails if actual and expected are not equal, converting expected
+// to the type of actual if the types are different but convertible.
+func (a *asserter) Equal(actual any, expected any) bool {
+	a.t.Helper()
+	if !isEqualConverted(actual, expected) {
+		a.recordValues(expected, actual)
+		if msg, ok := largeValueMismatch(expected, actual); ok {
+			a.Fail(msg)
+			return false
+		}
+		a.Fail(withDiff(fmt.Sprintf("got '%v' (%T). expected '%v' (%T)", actual, actual, expected, expected), expected, actual))
+		return false
+	}
+	return true
+}
+
+// EqualType fails if expected and actual are not of the same type.
+func (a *asserter) EqualType(expected, actual any) bool {
+	a.t.Helper()
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		a.failf("expected objects '%T' to be of the same type as object '%T'", expected, actual)
+		return false
+	}
+	return true
+}
+
+// IsType fails if actual is not of the expected type.
+func (a *asserter) IsType(expectedType reflect.Type, actual any) bool {
+	a.t.Helper()
+	if expectedType != reflect.TypeOf(actual) {
+		a.failf("expected objects '%s' to be of the same type as object '%T'", expectedType, actual)
+		return false
+	}
+	return true
+}
+
+// Contains fails if the string s does not contain the string contains,
+// or the slice s does not contain an element equal to contains.
+func (a *asserter) Contains(s any, contains any) bool {
+	a.t.Helper()
+	ok, found := containsElement(s, contains)
+	if !ok {
+		a.failf("unexpected argument types %T and %T", s, contains)
+		return false
+	}
+	if !found {
+		a.failf("%#v expected to contain %#v", s, contains)
+		return false
+	}
+	return true
+}
+
+// Err fails if err is nil.
+func (a *asserter) Err(err error) bool {
+	a.t.Helper()
+	if isNil(err) {
+		a.Fail("expected error")
+		return false
+	}
+	return true
+}
+
+// ErrMsg fails if err is nil or its message is not expectedMsg.
+func (a *asserter) ErrMsg(err error, expectedMsg string) bool {
+	a.t.Helper()
+	if isNil(err) {
+		a.failf("expected error %#v", expectedMsg)
+		return false
+	}
+	return a.Equal(err.Error(), expectedMsg)
+}
+
+// NotErr fails if err is not nil.
+func (a *asserter) NotErr(err error) bool {
+	a.t.Helper()
+	if !isNil(err) {
+		a.failf("expected no error, but got: %v", err)
+		return false
+	}
+	return true
+}
+
+// Nil fails if object is not nil.
+func (a *asserter) Nil(object any) bool {
+	a.t.Helper()
+	if !isNil(object) {
+		a.failf("expected object '%T' to be nil, but got: %v", object, object)
+		return false
+	}
+	return true
+}
+
+// NotNil fails if object is nil, including a nil pointer (or other nil
+// value) stored in a non-nil interface, which is reported with its type.
+func (a *asserter) NotNil(object any) bool {
+	a.t.Helper()
+	if object == nil {
+		a.failf("expected object not to be nil")
+		return false
+	}
+	if isNil(object) {
+		a.failf("expected object not to be nil, but got a nil value of type '%T' (typed nil)", object)
+		return false
+	}
+	return true
+}
+
+// True fails if value is false.
+func (a *asserter) True(value bool) bool {
+	a.t.Helper()
+	if !value {
+		a.Fail("expected boolean to be true")
+		return false
+	}
+	return true
+}
+
+// False fails if value is true.
+func (a *asserter) False(value bool) bool {
+	a.t.Helper()
+	if value {
+		a.Fail("expected boolean to be false")
+		return false
+	}
+	return true
+}
+
+// Len fails if object is not an array, slice or map of the given length.
+func (a *asserter) Len(object any, length int) bool {
+	a.t.Helper()
+	if isIterator(object) {
+		elements, err := drainIterator(object, maxIteratorElements)
+		if err != nil {
+			a.Fail(err.Error())
+			return false
+		}
+		if len(elements) != length {
+			a.failf("expected iterator '%T' to yield %d elements but it yielded: %d", object, length, len(elements))
+			return false
+		}
+		return true
+	}
+	if l, ok := object.(lener); ok {
+		if isNil(object) {
+			a.failf("expected object '%T' to be of length '%d', but it is nil", object, length)
+			return false
+		}
+		if objLen := l.Len(); objLen != length {
+			a.failf("expected object '%T' to be of length '%d' but its Len method returned: %d", object, length, objLen)
+			return false
+		}
+		return true
+	}
+	objType := reflect.TypeOf(object)
+	if object == nil ||
+		(objType.Kind() != reflect.Array &&
+			objType.Kind() != reflect.Slice &&
+			objType.Kind() != reflect.Map) {
+		a.failf("expected object '%T' to be of length '%d', but the object is not one of array, slice, map, iterator or a type with a Len() int method", object, length)
+		return false
+	}
+	objLen := reflect.ValueOf(object).Len()
+	if objLen != length {
+		a.failf("expected object '%T' to be of length '%d' but it was: %d", object, length, objLen)
+		return false
+	}
+	return true
+}
+
+// ShouldPanic fails if f does not panic.
+func (a *asserter) ShouldPanic(f func()) {
+	a.t.Helper()
+	defer func() {
+		if r := recover(); r == nil {
+			a.Fail("expected function to panic")
+		}
+	}()
+	f()
+}
@@ -0,0 +1,89 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "fmt"
+
+// Assertions provides assertion methods around the TestingT interface.
+type Assertions struct {
+	t TestingT
+}
+
+// New makes a new Assertions object for the specified TestingT.
+//
+// The Assertions belong to the test of t: using them to report a failure
+// after that test has finished, typically from a goroutine or a parallel
+// subtest that captured them, panics with a message explaining the misuse
+// (and the failure), instead of the "has completed" panic of the testing
+// package.
+func New(t TestingT) *Assertions {
+	t.Helper()
+	return &Assertions{
+		t: newOwnedT(t),
+	}
+}
+
+// innerT returns the TestingT wrapped by t, if t is one of the TestingT
+// wrappers of this package, such as the TestingT of Soft, or nil.
+func innerT(t TestingT) TestingT {
+	switch w := t.(type) {
+	case softT:
+		return w.TestingT
+	case strictT:
+		return w.TestingT
+	case *prefixT:
+		return w.TestingT
+	case *syncT:
+		return w.TestingT
+	case *budgetT:
+		return w.TestingT
+	case *interceptT:
+		return w.TestingT
+	case *collectT:
+		return w.TestingT
+	case warnT:
+		return w.TestingT
+	case *spanT:
+		return w.TestingT
+	case *clockT:
+		return w.TestingT
+	case *ownedT:
+		return w.TestingT
+	}
+	return nil
+}
+
+// With returns Assertions whose failure messages are prefixed with the
+// formatted context, like "user=42 attempt=3: ...", so that assertions in
+// a loop do not need to repeat it in msgAndArgs:
+//
+//	for i, id := range ids {
+//		r := require.New(t).With("user=%d attempt=%d", id, i)
+//		r.NoError(err)
+//	}
+//
+// Prefixes of nested calls of With and Group are joined with " > ".
+func (a *Assertions) With(format string, args ...any) *Assertions {
+	return &Assertions{t: withLabel(a.t, fmt.Sprintf(format, args...))}
+}
@@ -0,0 +1,106 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "testing"
+
+// benchT adapts *testing.B for assertions: the timer is stopped before
+// a failure is formatted, and every failure is reported via b.Fatalf.
+type benchT struct {
+	*testing.B
+}
+
+// Bench returns a TestingT for using assertions inside benchmarks.
+// The benchmark timer is stopped while failure messages are formatted,
+// so that failures don't distort the measurement, and failures always
+// stop the benchmark (even with assertions that don't stop the test).
+//
+//	func BenchmarkParse(b *testing.B) {
+//		t := require.Bench(b)
+//		for i := 0; i < b.N; i++ {
+//			v, err := Parse(input)
+//			require.NoError(t, err)
+//			require.Equal(t, 5, v)
+//		}
+//	}
+func Bench(b *testing.B) TestingT {
+	return benchT{B: b}
+}
+
+func (b benchT) Error(args ...any) {
+	b.B.StopTimer()
+	b.B.Helper()
+	b.B.Fatal(args...)
+}
+
+func (b benchT) Errorf(format string, args ...any) {
+	b.B.StopTimer()
+	b.B.Helper()
+	b.B.Fatalf(format, args...)
+}
+
+func (b benchT) Fatal(args ...any) {
+	b.B.StopTimer()
+	b.B.Helper()
+	b.B.Fatal(args...)
+}
+
+func (b benchT) Fatalf(format string, args ...any) {
+	b.B.StopTimer()
+	b.B.Helper()
+	b.B.Fatalf(format, args...)
+}
+
+// ReportMetricEqual reports the metric with b.ReportMetric (see its
+// documentation about the unit), and fails the benchmark if its value
+// is not equal to expected.
+func ReportMetricEqual(b *testing.B, expected float64, value float64, unit string) {
+	b.Helper()
+	b.ReportMetric(value, unit)
+	if value != expected {
+		b.Fatalf("metric %q: expected %v, but got %v", unit, expected, value)
+	}
+}
+
+// ReportMetricAtMost reports the metric with b.ReportMetric (see its
+// documentation about the unit), and fails the benchmark if its value
+// is greater than max.
+func ReportMetricAtMost(b *testing.B, max float64, value float64, unit string) {
+	b.Helper()
+	b.ReportMetric(value, unit)
+	if value > max {
+		b.Fatalf("metric %q: expected at most %v, but got %v", unit, max, value)
+	}
+}
+
+// ReportMetricAtLeast reports the metric with b.ReportMetric (see its
+// documentation about the unit), and fails the benchmark if its value
+// is less than min.
+func ReportMetricAtLeast(b *testing.B, min float64, value float64, unit string) {
+	b.Helper()
+	b.ReportMetric(value, unit)
+	if value < min {
+		b.Fatalf("metric %q: expected at least %v, but got %v", unit, min, value)
+	}
+}
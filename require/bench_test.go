@@ -0,0 +1,95 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"testing"
+)
+
+// The benchmarks of passing assertions, which should not allocate apart
+// from the boxing of arguments (none for small integers and constants) and
+// the slice of msgAndArgs.
+
+func BenchmarkEqual(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Equal(b, 42, 42)
+	}
+}
+
+func BenchmarkEqualWithMessage(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Equal(b, "ok", "ok", "user %d", 1)
+	}
+}
+
+func BenchmarkEqualSlice(b *testing.B) {
+	expected := []int{1, 2, 3}
+	actual := []int{1, 2, 3}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Equal(b, expected, actual)
+	}
+}
+
+func BenchmarkTrue(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		True(b, true)
+	}
+}
+
+func BenchmarkNoError(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NoError(b, nil)
+	}
+}
+
+func BenchmarkAssertionsEqual(b *testing.B) {
+	r := New(b)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		r.Equal(42, 42)
+	}
+}
+
+// TestPassingAssertionsDoNotAllocate checks the allocations measured by
+// the benchmarks, so that regressions fail the tests.
+func TestPassingAssertionsDoNotAllocate(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func()
+	}{
+		{"Equal", func() { Equal(t, 42, 42) }},
+		{"True", func() { True(t, true) }},
+		{"NoError", func() { NoError(t, nil) }},
+	}
+	for _, tt := range tests {
+		if allocs := testing.AllocsPerRun(100, tt.f); allocs > 0 {
+			t.Errorf("%s: expected no allocations, got %v", tt.name, allocs)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// budgetT is a TestingT with a time budget for slow assertions, see
+// WithBudget.
+type budgetT struct {
+	TestingT
+	budget time.Duration
+
+	mu    sync.Mutex
+	spent time.Duration
+}
+
+// WithBudget returns Assertions that track the cumulative time spent in
+// potentially slow assertions: polling assertions (such as Eventually,
+// Never, EventuallyHTTPSuccess and DialSucceedsWithin), HTTP handler
+// assertions, commands and golden files. Once budget is spent, they fail
+// with a message showing the time spent, and polling assertions do not
+// wait beyond the remaining budget, to keep integration tests within the
+// time limits of CI:
+//
+//	r := require.WithBudget(t, 30*time.Second)
+//	r.EventuallyHTTPSuccess(url, time.Minute, time.Second)
+//	r.CmdSucceeds(exec.Command("./migrate"))
+//
+// Other assertions are not counted. The budget is shared by the Assertions
+// derived from the returned ones, such as by Soft or With.
+func WithBudget(t TestingT, budget time.Duration) *Assertions {
+	return &Assertions{t: &budgetT{TestingT: t, budget: budget}}
+}
+
+// findBudget returns the budgetT that t is or wraps, or nil.
+func findBudget(t TestingT) *budgetT {
+	for ; t != nil; t = innerT(t) {
+		if b, ok := t.(*budgetT); ok {
+			return b
+		}
+	}
+	return nil
+}
+
+// exceeded returns the failure message of an exhausted budget.
+func (b *budgetT) exceeded(spent time.Duration) string {
+	return fmt.Sprintf("assertion time budget of %v exceeded: %v spent in slow assertions", b.budget, spent.Round(time.Millisecond))
+}
+
+// budgetSpan is the time spent by a slow assertion. Its methods do nothing
+// on a nil budgetSpan, for tests without a budget.
+type budgetSpan struct {
+	b     *budgetT
+	start time.Time
+}
+
+// startBudget starts the span of a slow assertion, if the test has a
+// budget, or fails and returns false if the budget is already spent.
+func startBudget(a *asserter) (*budgetSpan, bool) {
+	a.t.Helper()
+	b := findBudget(a.t)
+	if b == nil {
+		return nil, true
+	}
+	b.mu.Lock()
+	spent := b.spent
+	b.mu.Unlock()
+	if spent >= b.budget {
+		a.Fail(b.exceeded(spent))
+		return nil, false
+	}
+	return &budgetSpan{b: b, start: time.Now()}, true
+}
+
+// capWait caps waitFor to the remaining budget.
+func (s *budgetSpan) capWait(waitFor time.Duration) time.Duration {
+	if s == nil {
+		return waitFor
+	}
+	s.b.mu.Lock()
+	remaining := s.b.budget - s.b.spent - time.Since(s.start)
+	s.b.mu.Unlock()
+	if remaining < 0 {
+		remaining = 0
+	}
+	if remaining < waitFor {
+		return remaining
+	}
+	return waitFor
+}
+
+// end adds the time of the span to the spent budget, and fails and returns
+// false if the budget is exceeded.
+func (s *budgetSpan) end(a *asserter) bool {
+	a.t.Helper()
+	if s == nil {
+		return true
+	}
+	s.b.mu.Lock()
+	s.b.spent += time.Since(s.start)
+	spent := s.b.spent
+	s.b.mu.Unlock()
+	if spent >= s.b.budget {
+		a.Fail(s.b.exceeded(spent))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// chanValue returns the reflect.Value of ch if it is a channel.
+func chanValue(a *asserter, ch any) (reflect.Value, bool) {
+	a.t.Helper()
+	if ch == nil {
+		a.Fail("expected a channel, but got nil")
+		return reflect.Value{}, false
+	}
+	value := reflect.ValueOf(ch)
+	if value.Kind() != reflect.Chan {
+		a.Fail(fmt.Sprintf("expected a channel, but got %T", ch))
+		return reflect.Value{}, false
+	}
+	return value, true
+}
+
+// ChanLen asserts that the number of elements queued in the channel buffer
+// is equal to length.
+func ChanLen(t TestingT, ch any, length int, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ChanLen", ch, length, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	value, ok := chanValue(a, ch)
+	if !ok {
+		return
+	}
+	if value.Len() != length {
+		a.Fail(fmt.Sprintf("expected channel %T to have %d queued elements, but it has %d", ch, length, value.Len()))
+	}
+}
+
+// ChanCap asserts that the buffer capacity of the channel is equal to capacity.
+func ChanCap(t TestingT, ch any, capacity int, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ChanCap", ch, capacity, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	value, ok := chanValue(a, ch)
+	if !ok {
+		return
+	}
+	if value.Cap() != capacity {
+		a.Fail(fmt.Sprintf("expected channel %T to have capacity %d, but it has %d", ch, capacity, value.Cap()))
+	}
+}
+
+// Drained asserts that the channel is closed and has no remaining elements.
+// It never blocks: remaining elements are received until the channel is
+// found to be closed or a receive would block.
+// The remaining elements (if any) are returned so that they can be inspected.
+func Drained[T any](t TestingT, ch <-chan T, msgAndArgs ...any) []T {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Drained", ch, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	var remaining []T
+	for {
+		select {
+		case elem, ok := <-ch:
+			if ok {
+				remaining = append(remaining, elem)
+				continue
+			}
+			if len(remaining) > 0 {
+				a.Fail(fmt.Sprintf("channel is closed but not empty, %d elements remained: %v", len(remaining), remaining))
+			}
+			return remaining
+		default:
+			if len(remaining) > 0 {
+				a.Fail(fmt.Sprintf("channel is not closed, %d elements remained: %v", len(remaining), remaining))
+				return remaining
+			}
+			a.Fail("channel is not closed")
+			return remaining
+		}
+	}
+}
+
+// FromChan receives an element from the channel and returns it, failing if
+// the channel is closed. Like Drained, it never blocks: it fails if no
+// element is ready to be received.
+func FromChan[T any](t TestingT, ch <-chan T, msgAndArgs ...any) T {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FromChan", ch, msgAndArgs)()
+	}
+	select {
+	case elem, ok := <-ch:
+		if !ok {
+			newAsserter(t, msgAndArgs).Fail(fmt.Sprintf("expected to receive from channel %T, but it is closed", ch))
+		}
+		return elem
+	default:
+		newAsserter(t, msgAndArgs).Fail(fmt.Sprintf("expected to receive from channel %T, but no element is ready", ch))
+		var zero T
+		return zero
+	}
+}
@@ -0,0 +1,130 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "time"
+
+// Clock is the source of time of polling and timeout assertions, see
+// WithClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel receiving the current time after d.
+	After(d time.Duration) <-chan time.Time
+	// NewTicker returns a Ticker sending the current time every d.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker sends the current time periodically on a channel, like
+// time.Ticker.
+type Ticker interface {
+	// Chan returns the channel of the ticks.
+	Chan() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// realClock is the Clock of the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+// realTicker is the Ticker of realClock.
+type realTicker struct {
+	*time.Ticker
+}
+
+func (t realTicker) Chan() <-chan time.Time {
+	return t.C
+}
+
+// clockT is a TestingT with a Clock, see WithClock.
+type clockT struct {
+	TestingT
+	clock Clock
+}
+
+// WithClock returns Assertions whose polling and timeout assertions use
+// clock instead of the time package: Eventually, Never, EventuallyHealthy,
+// EventuallyFileExists, EventuallyFileContains, CompletesWithin and
+// WaitsWithin. With a fake clock, tests of code using a fake clock (and
+// tests of assertions) run deterministically, without real sleeping:
+//
+//	clock := fakeclock.New()
+//	r := require.WithClock(t, clockAdapter{clock})
+//	go func() {
+//		// wait for the timer and the ticker of Never
+//		clock.BlockUntil(2)
+//		clock.Advance(time.Minute)
+//	}()
+//	r.Never(cache.Expired, time.Minute, time.Second)
+//
+// The clock must only be advanced once the assertion waits on it, as a
+// fake clock does not fire the timers created after it is advanced.
+// WithinDuration compares the given times, so the expected time is taken
+// from the clock by the caller, such as clock.Now(). The clock is kept by
+// the Assertions derived from the returned ones, such as by Soft or With.
+// Test deadlines and time budgets (see WithBudget) are still measured
+// with the time package.
+func WithClock(t TestingT, clock Clock) *Assertions {
+	return &Assertions{t: &clockT{TestingT: t, clock: clock}}
+}
+
+// findClock returns the Clock of the clockT that t is or wraps, or the
+// clock of the time package.
+func findClock(t TestingT) Clock {
+	for ; t != nil; t = innerT(t) {
+		if c, ok := t.(*clockT); ok {
+			return c.clock
+		}
+	}
+	return realClock{}
+}
+
+// TestDeadline returns the deadline of the test, if t (or the TestingT
+// wrapped by t, such as by New or Soft) has one, for helpers that cap
+// their own timeouts like the polling assertions do.
+// Tests in a testing/synctest bubble have no deadline, since the deadline
+// is not related to the fake clock of the bubble (and Deadline panics).
+func TestDeadline(t TestingT) (time.Time, bool) {
+	if inSynctestBubble() {
+		return time.Time{}, false
+	}
+	for ; t != nil; t = innerT(t) {
+		if dt, ok := t.(interface{ Deadline() (time.Time, bool) }); ok {
+			return dt.Deadline()
+		}
+	}
+	return time.Time{}, false
+}
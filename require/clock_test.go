@@ -0,0 +1,195 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock whose time only changes with Advance.
+type fakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+// fakeWaiter is a timer (period 0) or a ticker of fakeClock.
+type fakeWaiter struct {
+	at     time.Time
+	period time.Duration
+	ch     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	c := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) add(d, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &fakeWaiter{at: c.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	c.waiters = append(c.waiters, w)
+	c.cond.Broadcast()
+	return w
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	return c.add(d, 0).ch
+}
+
+func (c *fakeClock) NewTicker(d time.Duration) Ticker {
+	return &fakeTicker{clock: c, waiter: c.add(d, d)}
+}
+
+// BlockUntil waits until n timers and tickers wait on the clock.
+func (c *fakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the time forward, firing the timers and tickers due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	waiters := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.at.After(c.now) {
+			waiters = append(waiters, w)
+			continue
+		}
+		select {
+		case w.ch <- c.now:
+		default:
+		}
+		if w.period > 0 {
+			for !w.at.After(c.now) {
+				w.at = w.at.Add(w.period)
+			}
+			waiters = append(waiters, w)
+		}
+	}
+	c.waiters = waiters
+}
+
+func (c *fakeClock) remove(w *fakeWaiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, other := range c.waiters {
+		if other == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// fakeTicker is the Ticker of fakeClock.
+type fakeTicker struct {
+	clock  *fakeClock
+	waiter *fakeWaiter
+}
+
+func (t *fakeTicker) Chan() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *fakeTicker) Stop() {
+	t.clock.remove(t.waiter)
+}
+
+func TestWithClock(t *testing.T) {
+	poll := func(t *testing.T, advance time.Duration, f func(r *Assertions)) *fakeT {
+		t.Helper()
+		clock := newFakeClock()
+		ft := newFakeT(t)
+		go func() {
+			// wait for the timer and the ticker of the assertion
+			clock.BlockUntil(2)
+			clock.Advance(advance)
+		}()
+		start := time.Now()
+		f(WithClock(ft, clock))
+		if elapsed := time.Since(start); elapsed > 10*time.Second {
+			t.Errorf("expected the fake clock to be used, waited %v", elapsed)
+		}
+		return ft
+	}
+
+	t.Run("Eventually", func(t *testing.T) {
+		ft := poll(t, time.Minute, func(r *Assertions) {
+			r.Eventually(func() bool { return true }, time.Hour, time.Minute)
+		})
+		expectFailed(t, ft, false)
+	})
+	t.Run("EventuallyTimeout", func(t *testing.T) {
+		ft := poll(t, time.Hour, func(r *Assertions) {
+			r.Eventually(func() bool { return false }, time.Hour, 2*time.Hour)
+		})
+		expectFailed(t, ft, true)
+	})
+	t.Run("Never", func(t *testing.T) {
+		ft := poll(t, time.Hour, func(r *Assertions) {
+			r.Never(func() bool { return false }, time.Hour, 2*time.Hour)
+		})
+		expectFailed(t, ft, false)
+	})
+	t.Run("NeverSatisfied", func(t *testing.T) {
+		ft := poll(t, time.Minute, func(r *Assertions) {
+			r.Never(func() bool { return true }, time.Hour, time.Minute)
+		})
+		expectFailed(t, ft, true)
+		expectMessage(t, ft, "condition satisfied")
+	})
+}
+
+func TestTestDeadline(t *testing.T) {
+	want, wantOK := t.Deadline()
+	for name, tt := range map[string]TestingT{
+		"T":    t,
+		"Soft": Soft(t),
+		"With": WithClock(t, realClock{}).Soft().t,
+	} {
+		got, ok := TestDeadline(tt)
+		if ok != wantOK || !got.Equal(want) {
+			t.Errorf("%s: TestDeadline = %v, %v, want %v, %v", name, got, ok, want, wantOK)
+		}
+	}
+	if _, ok := TestDeadline(&fakeT{}); ok {
+		t.Error("TestDeadline of a TestingT without Deadline returned ok")
+	}
+}
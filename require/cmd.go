@@ -0,0 +1,214 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+var cmdTimeout = time.Minute
+
+// SetCmdTimeout sets the maximum time a command run by the command
+// assertions (CmdSucceeds, CmdFailsWith and CmdOutputContains) may take
+// before it is killed (one minute by default). The timeout is also capped
+// to the deadline of the test.
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetCmdTimeout(timeout time.Duration) {
+	cmdTimeout = timeout
+}
+
+// cmdResult is the outcome of running a command.
+type cmdResult struct {
+	output   string
+	exitCode int
+	err      error // error other than a non-zero exit status
+	timedOut bool
+	timeout  time.Duration
+}
+
+// lockedBuffer is a bytes.Buffer safe for concurrent writes, as exec.Cmd
+// copies stdout and stderr in separate goroutines when they are not both
+// the same writer.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// describe returns the command line and its output, for failure messages.
+func (r *cmdResult) describe(cmd *exec.Cmd) string {
+	return fmt.Sprintf("command: %s\noutput:\n%s", strings.Join(cmd.Args, " "), tail(r.output))
+}
+
+// runCmd runs cmd with a timeout (cmdTimeout, capped by the budget of
+// span and the test deadline), capturing its combined stdout and stderr
+// (while still writing them to cmd.Stdout and cmd.Stderr if they are set).
+func runCmd(t TestingT, cmd *exec.Cmd, span *budgetSpan) *cmdResult {
+	timeout, _ := capToDeadline(t, span.capWait(cmdTimeout))
+	var output lockedBuffer
+	if cmd.Stdout != nil {
+		cmd.Stdout = io.MultiWriter(cmd.Stdout, &output)
+	} else {
+		cmd.Stdout = &output
+	}
+	if cmd.Stderr != nil {
+		cmd.Stderr = io.MultiWriter(cmd.Stderr, &output)
+	} else {
+		cmd.Stderr = &output
+	}
+	if cmd.WaitDelay == 0 {
+		cmd.WaitDelay = time.Second
+	}
+
+	result := &cmdResult{timeout: timeout}
+	if err := cmd.Start(); err != nil {
+		result.err = err
+		return result
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	var err error
+	select {
+	case err = <-done:
+	case <-timer.C:
+		result.timedOut = true
+		_ = cmd.Process.Kill()
+		err = <-done
+	}
+	result.output = output.String()
+	var exitErr *exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		result.exitCode = exitErr.ExitCode()
+	case err != nil:
+		result.err = err
+	}
+	return result
+}
+
+// checkCmdRun fails if the command could not be run or timed out.
+func checkCmdRun(a *asserter, cmd *exec.Cmd, r *cmdResult) bool {
+	a.t.Helper()
+	switch {
+	case r.timedOut:
+		a.Fail(fmt.Sprintf("command timed out after %v\n%s", r.timeout, r.describe(cmd)))
+		return false
+	case r.err != nil:
+		a.Fail(fmt.Sprintf("could not run command: %v\n%s", r.err, r.describe(cmd)))
+		return false
+	}
+	return true
+}
+
+// CmdSucceeds asserts that the command runs and exits with code 0.
+// The combined output of the command is included in the failure message.
+func CmdSucceeds(t TestingT, cmd *exec.Cmd, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "CmdSucceeds", cmd, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	r := runCmd(t, cmd, span)
+	if !span.end(a) || !checkCmdRun(a, cmd, r) {
+		return
+	}
+	if r.exitCode != 0 {
+		a.Fail(fmt.Sprintf("command failed with exit code %d\n%s", r.exitCode, r.describe(cmd)))
+	}
+}
+
+// CmdFailsWith asserts that the command runs and exits with the given
+// non-zero exit code.
+// The combined output of the command is included in the failure message.
+func CmdFailsWith(t TestingT, cmd *exec.Cmd, exitCode int, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "CmdFailsWith", cmd, exitCode, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if exitCode == 0 {
+		a.Fail("CmdFailsWith needs a non-zero exit code, use CmdSucceeds instead")
+		return
+	}
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	r := runCmd(t, cmd, span)
+	if !span.end(a) || !checkCmdRun(a, cmd, r) {
+		return
+	}
+	if r.exitCode != exitCode {
+		a.Fail(fmt.Sprintf("expected command to exit with code %d, got %d\n%s", exitCode, r.exitCode, r.describe(cmd)))
+	}
+}
+
+// CmdOutputContains asserts that the command runs, and that its combined
+// stdout and stderr output contains the string contains, regardless of its
+// exit code.
+func CmdOutputContains(t TestingT, cmd *exec.Cmd, contains string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "CmdOutputContains", cmd, contains, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	r := runCmd(t, cmd, span)
+	if !span.end(a) || !checkCmdRun(a, cmd, r) {
+		return
+	}
+	if !strings.Contains(r.output, contains) {
+		a.Fail(fmt.Sprintf("command output does not contain %q\n%s", contains, r.describe(cmd)))
+	}
+}
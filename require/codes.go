@@ -0,0 +1,356 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"path"
+	"runtime"
+	"strings"
+)
+
+// failureCodes are the failure codes of assertions, by the name of their
+// package and function. The codes are stable: a code is never reused for
+// another assertion, and new assertions get new codes.
+var failureCodes = map[string]string{
+	"require.Equal":               "DMND-EQ001",
+	"require.EqualT":              "DMND-EQ002",
+	"require.EqualValues":         "DMND-EQ003",
+	"require.EqualWith":           "DMND-EQ004",
+	"require.EqualExportedValues": "DMND-EQ005",
+	"require.EqualUnordered":      "DMND-EQ006",
+	"require.Exactly":             "DMND-EQ007",
+	"require.NotEqual":            "DMND-EQ008",
+	"require.Same":                "DMND-EQ009",
+	"require.NotSame":             "DMND-EQ010",
+	"require.NumericEqual":        "DMND-EQ011",
+	"require.InDelta":             "DMND-EQ012",
+	"require.FloatSliceInDelta":   "DMND-EQ013",
+	"require.MatrixInDelta":       "DMND-EQ014",
+	"require.WithinDuration":      "DMND-EQ015",
+	"require.URLEqual":            "DMND-EQ016",
+	"require.QueryParamEqual":     "DMND-EQ017",
+	"require.NotEqualValues":      "DMND-EQ018",
+	"require.InDeltaSlice":        "DMND-EQ019",
+	"require.InDeltaMapValues":    "DMND-EQ020",
+	"require.InEpsilon":           "DMND-EQ021",
+	"require.InEpsilonSlice":      "DMND-EQ022",
+	"require.WithinRange":         "DMND-EQ023",
+
+	"require.Nil":          "DMND-NIL001",
+	"require.NotNil":       "DMND-NIL002",
+	"require.NilPtr":       "DMND-NIL003",
+	"require.NotNilPtr":    "DMND-NIL004",
+	"require.Zero":         "DMND-NIL005",
+	"require.NotZero":      "DMND-NIL006",
+	"require.Empty":        "DMND-NIL007",
+	"require.EmptyWith":    "DMND-NIL008",
+	"require.NotEmpty":     "DMND-NIL009",
+	"require.NotEmptyWith": "DMND-NIL010",
+
+	"require.True":      "DMND-BOOL001",
+	"require.False":     "DMND-BOOL002",
+	"require.Condition": "DMND-BOOL003",
+	"require.Fail":      "DMND-BOOL004",
+	"require.FailNow":   "DMND-BOOL005",
+
+	"require.Error":               "DMND-ERR001",
+	"require.NoError":             "DMND-ERR002",
+	"require.EqualError":          "DMND-ERR003",
+	"require.ErrorAs":             "DMND-ERR004",
+	"require.ErrorIs":             "DMND-ERR005",
+	"require.NotErrorIs":          "DMND-ERR006",
+	"require.ErrorContains":       "DMND-ERR007",
+	"require.ErrorCodeIs":         "DMND-ERR008",
+	"require.JoinedErrorContains": "DMND-ERR009",
+	"require.JoinedErrorIsAll":    "DMND-ERR010",
+	"require.Got":                 "DMND-ERR011",
+
+	"require.Contains":      "DMND-COL001",
+	"require.NotContains":   "DMND-COL002",
+	"require.ContainsKey":   "DMND-COL003",
+	"require.ContainsValue": "DMND-COL004",
+	"require.ElementsMatch": "DMND-COL005",
+	"require.Len":           "DMND-COL006",
+	"require.FromMap":       "DMND-COL007",
+	"require.Subset":        "DMND-COL008",
+	"require.NotSubset":     "DMND-COL009",
+
+	"require.Greater":         "DMND-ORD001",
+	"require.GreaterOrEqual":  "DMND-ORD002",
+	"require.Less":            "DMND-ORD003",
+	"require.LessOrEqual":     "DMND-ORD004",
+	"require.Positive":        "DMND-ORD005",
+	"require.Negative":        "DMND-ORD006",
+	"require.IsIncreasing":    "DMND-ORD007",
+	"require.IsNonIncreasing": "DMND-ORD008",
+	"require.IsDecreasing":    "DMND-ORD009",
+	"require.IsNonDecreasing": "DMND-ORD010",
+
+	"require.IsType":        "DMND-TYPE001",
+	"require.Implements":    "DMND-TYPE002",
+	"require.TypeAssert":    "DMND-TYPE003",
+	"require.NotImplements": "DMND-TYPE004",
+
+	"require.Regexp":          "DMND-STR001",
+	"require.NotRegexp":       "DMND-STR002",
+	"require.IsBase64":        "DMND-STR003",
+	"require.IsCIDR":          "DMND-STR004",
+	"require.IsEmail":         "DMND-STR005",
+	"require.IsHex":           "DMND-STR006",
+	"require.IsHostname":      "DMND-STR007",
+	"require.IsIP":            "DMND-STR008",
+	"require.IsIPv4":          "DMND-STR009",
+	"require.IsIPv6":          "DMND-STR010",
+	"require.IsSemver":        "DMND-STR011",
+	"require.IsUUID":          "DMND-STR012",
+	"require.IsValidUTF8":     "DMND-STR013",
+	"require.DecodesBase64To": "DMND-STR014",
+	"require.JSONEq":          "DMND-STR015",
+	"require.JSONEqWith":      "DMND-STR016",
+	"require.YAMLEq":          "DMND-STR017",
+
+	"require.Panics":                "DMND-PANIC001",
+	"require.NotPanics":             "DMND-PANIC002",
+	"require.PanicsWithError":       "DMND-PANIC003",
+	"require.PanicsWithValue":       "DMND-PANIC004",
+	"require.PanicsWithMatch":       "DMND-PANIC005",
+	"require.NotPanicsInGoroutines": "DMND-PANIC008",
+
+	"require.Eventually":            "DMND-ASYNC001",
+	"require.EventuallyWithT":       "DMND-ASYNC002",
+	"require.Never":                 "DMND-ASYNC003",
+	"require.CompletesWithin":       "DMND-ASYNC004",
+	"require.WaitsWithin":           "DMND-ASYNC005",
+	"require.RunConcurrently":       "DMND-ASYNC006",
+	"require.ChanLen":               "DMND-ASYNC007",
+	"require.ChanCap":               "DMND-ASYNC008",
+	"require.Drained":               "DMND-ASYNC009",
+	"require.ContextDone":           "DMND-ASYNC010",
+	"require.ContextNotDone":        "DMND-ASYNC011",
+	"require.ContextErrIs":          "DMND-ASYNC012",
+	"require.ContextDeadlineWithin": "DMND-ASYNC013",
+	"require.FromChan":              "DMND-ASYNC014",
+	"require.EventuallyHealthy":     "DMND-ASYNC015",
+
+	"require.HTTPSuccess":               "DMND-HTTP001",
+	"require.HTTPRedirect":              "DMND-HTTP002",
+	"require.HTTPError":                 "DMND-HTTP003",
+	"require.HTTPStatusCode":            "DMND-HTTP004",
+	"require.HTTPBodyContains":          "DMND-HTTP005",
+	"require.HTTPBodyNotContains":       "DMND-HTTP006",
+	"require.HTTPBodyMatchesGolden":     "DMND-HTTP007",
+	"require.HTTPContentType":           "DMND-HTTP008",
+	"require.EventuallyHTTPSuccess":     "DMND-HTTP009",
+	"require.EventuallyHTTPSuccessWith": "DMND-HTTP010",
+	"require.ContentType":               "DMND-HTTP011",
+	"require.ResponseContentType":       "DMND-HTTP012",
+	"require.MultipartContains":         "DMND-HTTP013",
+	"require.RequestMultipartContains":  "DMND-HTTP014",
+	"require.ResponseMultipartContains": "DMND-HTTP015",
+	"require.HTTPContentTypeWith":       "DMND-HTTP016",
+	"require.ResponseContentTypeWith":   "DMND-HTTP017",
+
+	"require.FileExists":             "DMND-SYS001",
+	"require.NoFileExists":           "DMND-SYS002",
+	"require.DirExists":              "DMND-SYS003",
+	"require.NoDirExists":            "DMND-SYS004",
+	"require.CmdSucceeds":            "DMND-SYS005",
+	"require.CmdFailsWith":           "DMND-SYS006",
+	"require.CmdOutputContains":      "DMND-SYS007",
+	"require.OutputContains":         "DMND-SYS008",
+	"require.OutputMatchesGolden":    "DMND-SYS009",
+	"require.DialSucceedsWithin":     "DMND-SYS010",
+	"require.TCPPortOpen":            "DMND-SYS011",
+	"require.EventuallyFileExists":   "DMND-SYS012",
+	"require.EventuallyFileContains": "DMND-SYS013",
+
+	"require.FasterThan":           "DMND-PERF001",
+	"require.FasterThanWith":       "DMND-PERF002",
+	"require.MaxAllocs":            "DMND-PERF003",
+	"require.NoHeapGrowth":         "DMND-PERF004",
+	"require.WithinResourceBudget": "DMND-PERF005",
+
+	"require.Match": "DMND-MATCH001",
+
+	"require.Flaky":      "DMND-RUN001",
+	"require.FlakyWith":  "DMND-RUN002",
+	"require.KnownIssue": "DMND-RUN003",
+	"require.ExpectFail": "DMND-RUN004",
+
+	"require.ExitsWith":       "DMND-EXIT001",
+	"require.ExitsWithStderr": "DMND-EXIT002",
+}
+
+// FailureCodes returns the failure codes of assertions, by the name of
+// their package and function, such as "require.Equal": "DMND-EQ001".
+// The f-variants and the methods of Assertions have the code of their
+// function.
+//
+// Failure messages include the code of the assertion and a link to its
+// documentation, like:
+//
+//	Code: DMND-EQ001 (https://pkg.go.dev/github.com/ilius/demand/require#Equal)
+//
+// so that tools parsing CI logs and runbooks can rely on the code instead
+// of the text of messages.
+func FailureCodes() map[string]string {
+	codes := make(map[string]string, len(failureCodes))
+	for name, code := range failureCodes {
+		codes[name] = code
+	}
+	return codes
+}
+
+// registeredCodes are the assertions of other modules given to
+// RegisterFailureCode.
+var registeredCodes = map[string]bool{}
+
+// RegisterFailureCode sets the failure code of an assertion, given by the
+// name of its package and function (or method, without the receiver type),
+// such as "myassert.Positive", for packages of assertions built on this
+// one, in this module or another. The code of a failure is the one of
+// the outermost assertion with a code that is not in a _test.go file,
+// while failures of helpers without a code have the code of the assertion
+// of this module they call, if any.
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func RegisterFailureCode(assertion string, code string) {
+	failureCodes[assertion] = code
+	registeredCodes[assertion] = true
+}
+
+// lookupCode returns the failure code of a function, or of the function
+// without its f suffix, if codes has the name.
+func lookupCode(key string, codes map[string]bool) (string, bool) {
+	for _, name := range []string{key, strings.TrimSuffix(key, "f")} {
+		if codes == nil || codes[name] {
+			if code, ok := failureCodes[name]; ok {
+				return code, true
+			}
+		}
+	}
+	return "", false
+}
+
+// splitFuncName splits the function name of a stack frame (see
+// runtime.Frame) into its package path, receiver type (or empty string)
+// and function name, without type arguments and closure suffixes.
+func splitFuncName(funcName string) (pkgPath string, recv string, name string) {
+	slash := strings.LastIndex(funcName, "/")
+	dot := strings.Index(funcName[slash+1:], ".")
+	if dot < 0 {
+		return "", "", funcName
+	}
+	pkgPath = funcName[:slash+1+dot]
+	parts := strings.Split(funcName[slash+1+dot+1:], ".")
+	if strings.HasPrefix(parts[0], "(") && len(parts) > 1 {
+		recv = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(parts[0], "("), "*"), ")")
+		parts = parts[1:]
+	}
+	name = parts[0]
+	if i := strings.Index(recv, "["); i >= 0 {
+		recv = recv[:i]
+	}
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+	return pkgPath, recv, name
+}
+
+// shimPackages are the packages providing the assertions of require under
+// another API, by import path.
+var shimPackages = map[string]bool{
+	libraryPath + "check":           true,
+	libraryPath + "testify/assert":  true,
+	libraryPath + "testify/require": true,
+}
+
+// failingAssertion returns the name of the failing assertion, such as
+// "require.Equal", its failure code and the link to its documentation,
+// skipping the given number of frames (0 is the caller of
+// failingAssertion). The assertion is the outermost function registered
+// by RegisterFailureCode for another module that calls the library (up to
+// the test code), or else the library function called by user code.
+// The functions of shimPackages have the code of the require function of
+// the same name. The code and link are empty if the assertion has no code:
+// the codes of the functions it calls are not reported, since they are
+// not the ones documenting the failure.
+func failingAssertion(skip int) (name string, code string, link string) {
+	pc := make([]uintptr, 64)
+	count := runtime.Callers(skip+2, pc)
+	frames := runtime.CallersFrames(pc[:count])
+	var library []runtime.Frame
+	var external *runtime.Frame
+	inLibrary := true
+	for {
+		frame, more := frames.Next()
+		switch {
+		case inLibrary && isLibraryFrame(frame):
+			library = append(library, frame)
+		case strings.HasPrefix(frame.Function, "testing.") || strings.HasSuffix(frame.File, "_test.go"):
+			more = false
+		case !isSystemFrame(frame):
+			inLibrary = false
+			pkgPath, _, funcName := splitFuncName(frame.Function)
+			if _, ok := lookupCode(path.Base(pkgPath)+"."+funcName, registeredCodes); ok {
+				frame := frame
+				external = &frame
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	if external != nil {
+		library = []runtime.Frame{*external}
+	}
+	if len(library) == 0 {
+		return "", "", ""
+	}
+	pkgPath, recv, funcName := splitFuncName(library[len(library)-1].Function)
+	name = path.Base(pkgPath) + "." + funcName
+	code, ok := lookupCode(name, nil)
+	if !ok && shimPackages[pkgPath] {
+		code, ok = lookupCode("require."+funcName, nil)
+	}
+	if !ok {
+		return name, "", ""
+	}
+	anchor := funcName
+	if recv != "" && recv != "Assertions" {
+		anchor = recv + "." + funcName
+	}
+	return name, code, "https://pkg.go.dev/" + pkgPath + "#" + anchor
+}
+
+// failureCode returns the line with the code of the failing assertion and
+// the link to its documentation, or empty string, skipping the given number
+// of frames (0 is the caller of failureCode), see failingAssertion.
+func failureCode(skip int) string {
+	_, code, link := failingAssertion(skip + 1)
+	if code == "" {
+		return ""
+	}
+	return "Code: " + code + " (" + link + ")"
+}
@@ -0,0 +1,183 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// errCollectStopped is used for stopping a function run by collectT.run
+// on FailNow, and recovered by it.
+var errCollectStopped = errors.New("require: stopped by FailNow")
+
+// collectT is a TestingT that records failures instead of reporting them,
+// see Collect, and Flaky, KnownIssue and ExpectFail, which run a function
+// with it, see run.
+type collectT struct {
+	TestingT
+	// stop makes FailNow (and fatal failures) stop the function given to
+	// run, instead of returning
+	stop bool
+
+	mu       sync.Mutex
+	failed   bool
+	messages []string
+}
+
+// record adds a failure message, prefixed with the location of the
+// failing call in user code.
+func (c *collectT) record(msg string) {
+	if frames := userFrames(2, 1); len(frames) > 0 {
+		msg = fmt.Sprintf("%s:%d: %s", filepath.Base(frames[0].File), frames[0].Line, msg)
+	}
+	c.mu.Lock()
+	c.failed = true
+	c.messages = append(c.messages, msg)
+	c.mu.Unlock()
+}
+
+func (c *collectT) Error(args ...any) {
+	c.record(fmt.Sprint(args...))
+}
+
+func (c *collectT) Errorf(format string, args ...any) {
+	c.record(fmt.Sprintf(format, args...))
+}
+
+func (c *collectT) Fatal(args ...any) {
+	c.record(fmt.Sprint(args...))
+	c.FailNow()
+}
+
+func (c *collectT) Fatalf(format string, args ...any) {
+	c.record(fmt.Sprintf(format, args...))
+	c.FailNow()
+}
+
+func (c *collectT) Fail() {
+	c.mu.Lock()
+	c.failed = true
+	c.mu.Unlock()
+}
+
+func (c *collectT) FailNow() {
+	c.Fail()
+	if c.stop {
+		panic(errCollectStopped)
+	}
+}
+
+func (c *collectT) Failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.failed
+}
+
+// run calls f with c, which must stop on FailNow, and returns the
+// description of its failures, or empty string if it passed.
+func (c *collectT) run(f func(t TestingT)) string {
+	func() {
+		defer func() {
+			if r := recover(); r != nil && r != errCollectStopped {
+				panic(r)
+			}
+		}()
+		f(c)
+	}()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.failed {
+		return ""
+	}
+	if len(c.messages) == 0 {
+		return "failed without a message"
+	}
+	return strings.Join(c.messages, "\n")
+}
+
+// Collector runs assertions without stopping the test on failure, and
+// reports all their failures together with Report. It is safe for
+// concurrent use.
+type Collector struct {
+	*Assertions
+	t TestingT
+	c *collectT
+}
+
+// Collect returns a Collector for the test, for checking many independent
+// properties and seeing all the failures at once:
+//
+//	c := require.Collect(t)
+//	defer c.Report()
+//	c.Equal(http.StatusOK, resp.StatusCode)
+//	c.Equal("application/json", resp.Header.Get("Content-Type"))
+//	c.NotEmpty(body.ID)
+//
+// The assertions return after recording their failure, like assertions of
+// testify/assert. Failures that are not reported with Report by the end of
+// the test are reported then.
+func Collect(t TestingT) *Collector {
+	c := &collectT{TestingT: t}
+	collector := &Collector{
+		Assertions: &Assertions{t: c},
+		t:          t,
+		c:          c,
+	}
+	t.Cleanup(collector.Report)
+	return collector
+}
+
+// Failed returns true if failures were recorded and not reported yet.
+func (c *Collector) Failed() bool {
+	c.c.mu.Lock()
+	defer c.c.mu.Unlock()
+	return len(c.c.messages) > 0
+}
+
+// Report fails the test once, with all the failures recorded since the
+// last Report, if any, and stops it.
+func (c *Collector) Report() {
+	c.t.Helper()
+	c.c.mu.Lock()
+	messages := c.c.messages
+	c.c.messages = nil
+	c.c.mu.Unlock()
+	if len(messages) == 0 {
+		return
+	}
+	var sb strings.Builder
+	if len(messages) == 1 {
+		sb.WriteString("1 assertion failed:")
+	} else {
+		fmt.Fprintf(&sb, "%d assertions failed:", len(messages))
+	}
+	for i, msg := range messages {
+		fmt.Fprintf(&sb, "\n%d. %s", i+1, strings.ReplaceAll(msg, "\n", "\n   "))
+	}
+	c.t.Fatal(sb.String())
+}
@@ -0,0 +1,226 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+)
+
+// goroutineDump returns the stack traces of all running goroutines.
+func goroutineDump() string {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// finishesWithin runs f in a new goroutine and reports whether it returned
+// within the given duration of clock.
+func finishesWithin(clock Clock, d time.Duration, f func()) bool {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+	select {
+	case <-done:
+		return true
+	case <-clock.After(d):
+		return false
+	}
+}
+
+// CompletesWithin asserts that f returns within the given duration.
+// f is run in a separate goroutine, so that the test fails (with a dump of
+// all goroutines) instead of deadlocking the test binary.
+func CompletesWithin(t TestingT, d time.Duration, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "CompletesWithin", d, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if finishesWithin(findClock(t), d, f) {
+		return
+	}
+	a.Fail(fmt.Sprintf("function did not complete within %v\n\ngoroutines:\n%s", d, goroutineDump()))
+}
+
+// WaitsWithin asserts that wg.Wait() returns within the given duration.
+func WaitsWithin(t TestingT, d time.Duration, wg *sync.WaitGroup, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "WaitsWithin", d, wg, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if finishesWithin(findClock(t), d, wg.Wait) {
+		return
+	}
+	a.Fail(fmt.Sprintf("WaitGroup was not done within %v\n\ngoroutines:\n%s", d, goroutineDump()))
+}
+
+// RunConcurrently calls f from n goroutines, each goroutine calling it
+// iterations times, all goroutines starting at the same time.
+// f receives the index of the call, from 0 to n*iterations-1, where calls
+// of goroutine g have indexes g*iterations to (g+1)*iterations-1.
+//
+// A goroutine stops at the first call that panics or calls FailNow (for
+// example a failed require assertion on the test's TestingT). After all
+// goroutines have finished, the panics (with their stack traces) and the
+// stopped goroutines are reported together in a single failure.
+func RunConcurrently(t TestingT, n int, iterations int, f func(i int), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "RunConcurrently", n, iterations, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	failures := make([]string, n)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for g := 0; g < n; g++ {
+		go func(g int) {
+			defer wg.Done()
+			<-start
+			runIterations(f, g*iterations, iterations, &failures[g])
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+	var reports []string
+	for g, failure := range failures {
+		if failure != "" {
+			reports = append(reports, fmt.Sprintf("goroutine %d: %s", g, failure))
+		}
+	}
+	if len(reports) == 0 {
+		return
+	}
+	a.Fail(fmt.Sprintf(
+		"%d of %d goroutines failed:\n\n%s",
+		len(reports), n, strings.Join(reports, "\n\n"),
+	))
+}
+
+// runIterations calls f(i) for i from first to first+count-1, stopping at
+// the first call that panics or calls runtime.Goexit (as FailNow does).
+// A description of the failure is stored in *failure, which is left
+// unchanged if all calls return normally.
+func runIterations(f func(i int), first int, count int, failure *string) {
+	i := first
+	returned := false
+	defer func() {
+		if r := recover(); r != nil {
+			*failure = fmt.Sprintf("panic in call %d: %v\n%s", i, r, debug.Stack())
+			return
+		}
+		if !returned {
+			// runtime.Goexit can not be stopped, but deferred calls still
+			// run, so the failure is recorded before the goroutine exits
+			*failure = fmt.Sprintf("call %d stopped by FailNow or runtime.Goexit", i)
+		}
+	}()
+	for ; i < first+count; i++ {
+		f(i)
+	}
+	returned = true
+}
+
+// NotPanicsInGoroutines returns a function to start goroutines with, in
+// place of the go statement, and asserts, when the test finishes, that
+// none of the goroutines started by it panicked. A panic in a goroutine
+// otherwise crashes the whole test binary, losing the results of the
+// other tests; here it is recovered and reported as a failure of the
+// test, along with its stack trace.
+//
+//	goroutine := require.NotPanicsInGoroutines(t)
+//	for _, job := range jobs {
+//		goroutine(func() {
+//			process(job)
+//		})
+//	}
+//
+// A goroutine stopped by runtime.Goexit (as FailNow does) is reported as
+// well. The check runs as a cleanup of the test, which first waits for all
+// the started goroutines to return.
+func NotPanicsInGoroutines(t TestingT, msgAndArgs ...any) func(f func()) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotPanicsInGoroutines", msgAndArgs)()
+	}
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		started  int
+		failures []string
+	)
+	t.Cleanup(func() {
+		t.Helper()
+		wg.Wait()
+		mu.Lock()
+		defer mu.Unlock()
+		if len(failures) == 0 {
+			return
+		}
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf(
+			"%d of %d goroutines panicked:\n\n%s",
+			len(failures), started, strings.Join(failures, "\n\n"),
+		))
+	})
+	return func(f func()) {
+		mu.Lock()
+		g := started
+		started++
+		mu.Unlock()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			returned := false
+			defer func() {
+				failure := ""
+				if r := recover(); r != nil {
+					failure = fmt.Sprintf("goroutine %d: panic: %v\n%s", g, r, debug.Stack())
+				} else if !returned {
+					failure = fmt.Sprintf("goroutine %d: stopped by FailNow or runtime.Goexit", g)
+				} else {
+					return
+				}
+				mu.Lock()
+				failures = append(failures, failure)
+				mu.Unlock()
+			}()
+			f()
+			returned = true
+		}()
+	}
+}
@@ -0,0 +1,76 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "reflect"
+
+// Container is implemented by custom collection types, such as ordered
+// maps, ring buffers and sets, so that Len, Empty, NotEmpty, Contains,
+// NotContains and ElementsMatch work on them without converting them to
+// slices:
+//
+//	func (s *Set[T]) Len() int { return len(s.m) }
+//
+//	func (s *Set[T]) Iterate(yield func(element any) bool) {
+//		for e := range s.m {
+//			if !yield(e) {
+//				return
+//			}
+//		}
+//	}
+//
+// A Contains method of the type, if any, is used by Contains instead of
+// Iterate, see Contains.
+type Container interface {
+	// Len returns the number of elements.
+	Len() int
+	// Iterate calls yield for each element, until it returns false.
+	Iterate(yield func(element any) bool)
+}
+
+var (
+	containerType = reflect.TypeOf((*Container)(nil)).Elem()
+	anyType       = reflect.TypeOf((*any)(nil)).Elem()
+)
+
+// containerElements returns the elements of c.
+func containerElements(c Container) []any {
+	elements := make([]any, 0, c.Len())
+	c.Iterate(func(element any) bool {
+		elements = append(elements, element)
+		return true
+	})
+	return elements
+}
+
+// containerContains checks if c has an element equal to element, stopping
+// the iteration at the first one.
+func containerContains(c Container, element any) bool {
+	found := false
+	c.Iterate(func(e any) bool {
+		found = isEqualConverted(e, element)
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,97 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ContextDone asserts that the context is done (canceled or timed out).
+// It does not wait for the context.
+func ContextDone(t TestingT, ctx context.Context, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContextDone", ctx, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	select {
+	case <-ctx.Done():
+	default:
+		a.Fail("expected context to be done")
+	}
+}
+
+// ContextNotDone asserts that the context is not done yet.
+func ContextNotDone(t TestingT, ctx context.Context, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContextNotDone", ctx, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	select {
+	case <-ctx.Done():
+		a.Fail(fmt.Sprintf("expected context not to be done, but it is: %v", ctx.Err()))
+	default:
+	}
+}
+
+// ContextErrIs asserts that the context is done and errors.Is(ctx.Err(), target),
+// for example context.Canceled or context.DeadlineExceeded.
+func ContextErrIs(t TestingT, ctx context.Context, target error, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContextErrIs", ctx, target, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	err := ctx.Err()
+	if err == nil {
+		a.Fail(fmt.Sprintf("expected context error %q, but context is not done", target))
+		return
+	}
+	if !errors.Is(err, target) {
+		a.Fail(fmt.Sprintf("expected context error %q, but got %q", target, err))
+	}
+}
+
+// ContextDeadlineWithin asserts that the context has a deadline, and that
+// the deadline is not later than d from now.
+func ContextDeadlineWithin(t TestingT, ctx context.Context, d time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContextDeadlineWithin", ctx, d, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		a.Fail("expected context to have a deadline")
+		return
+	}
+	remaining := time.Until(deadline)
+	if remaining > d {
+		a.Fail(fmt.Sprintf("expected context deadline within %v, but it is in %v", d, remaining))
+	}
+}
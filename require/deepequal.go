@@ -0,0 +1,310 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EqualOptions configures the deep comparison of EqualWith.
+type EqualOptions struct {
+	// IgnoreOrder compares all arrays and slices, at any depth, as
+	// unordered multisets of elements.
+	IgnoreOrder bool
+
+	// IgnoreZeroFields ignores the struct fields, at any depth, that have
+	// their zero value in expected, so that only the non-zero fields of
+	// expected are compared, for partial matching of large structs:
+	//
+	//	require.EqualWith(t, User{Name: "alice", Admin: true}, user, require.EqualOptions{IgnoreZeroFields: true})
+	//
+	// A false or 0 expectation is therefore not checked either.
+	IgnoreZeroFields bool
+
+	// NumbersByValue compares numbers of different integer and
+	// floating-point types by value, see NumericEqual, so that for example
+	// map[string]any{"count": 2} equals the result of decoding
+	// {"count": 2} from JSON, which holds a float64.
+	NumbersByValue bool
+}
+
+// comparer compares values deeply according to options, and describes the
+// first difference.
+type comparer struct {
+	opts EqualOptions
+	// exportedOnly ignores the unexported struct fields, at any depth
+	exportedOnly bool
+	// strictNil distinguishes nil and empty slices and maps, like
+	// reflect.DeepEqual
+	strictNil bool
+	// visited are the pairs of pointers being compared, to stop at cycles
+	visited map[visit]bool
+}
+
+// visit is a pair of pointers compared by comparer.
+type visit struct {
+	expected, actual uintptr
+	typ              reflect.Type
+}
+
+// describePath returns the path of a value for failure messages.
+func describePath(path string) string {
+	return "value" + path
+}
+
+// compare returns a description of the first difference between expected
+// and actual, whose location is path, or "" if they are equal.
+func (c *comparer) compare(path string, expected, actual reflect.Value) string {
+	if !expected.IsValid() || !actual.IsValid() {
+		if expected.IsValid() != actual.IsValid() {
+			return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+		}
+		return ""
+	}
+	if c.opts.NumbersByValue {
+		expectedNum, actualNum := toNumeric(expected), toNumeric(actual)
+		if expectedNum.valid && actualNum.valid {
+			if !numericEqual(expectedNum, actualNum) {
+				return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+			}
+			return ""
+		}
+	}
+	if expected.Type() != actual.Type() {
+		return fmt.Sprintf("%s: expected type %v, actual type %v", describePath(path), expected.Type(), actual.Type())
+	}
+	switch expected.Kind() {
+	case reflect.Array:
+		return c.compareList(path, expected, actual)
+	case reflect.Slice:
+		if expected.IsNil() != actual.IsNil() && (c.strictNil || expected.Len() > 0 || actual.Len() > 0) {
+			return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+		}
+		return c.compareList(path, expected, actual)
+	case reflect.Map:
+		return c.compareMap(path, expected, actual)
+	case reflect.Struct:
+		for i := 0; i < expected.NumField(); i++ {
+			field := expected.Type().Field(i)
+			if c.exportedOnly && !field.IsExported() {
+				continue
+			}
+			if c.opts.IgnoreZeroFields && expected.Field(i).IsZero() {
+				continue
+			}
+			if diff := c.compare(path+"."+field.Name, expected.Field(i), actual.Field(i)); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	case reflect.Ptr, reflect.Interface:
+		if expected.IsNil() || actual.IsNil() {
+			if expected.IsNil() != actual.IsNil() {
+				return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+			}
+			return ""
+		}
+		if expected.Kind() == reflect.Ptr {
+			if expected.Pointer() == actual.Pointer() {
+				return ""
+			}
+			v := visit{expected.Pointer(), actual.Pointer(), expected.Type()}
+			if c.visited[v] {
+				// already being compared, in a cycle
+				return ""
+			}
+			if c.visited == nil {
+				c.visited = map[visit]bool{}
+			}
+			c.visited[v] = true
+			// only the pairs in progress are tracked, since the same pair
+			// can be compared again, such as by compareList with IgnoreOrder
+			defer delete(c.visited, v)
+		}
+		return c.compare(path, expected.Elem(), actual.Elem())
+	}
+	if !leafEqual(expected, actual) {
+		return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+	}
+	return ""
+}
+
+// compareList compares arrays or slices, in order, or as multisets if
+// IgnoreOrder is set.
+func (c *comparer) compareList(path string, expected, actual reflect.Value) string {
+	if expected.Len() != actual.Len() {
+		return fmt.Sprintf(
+			"%s: expected %d elements, actual %d\n\texpected: %v\n\tactual  : %v",
+			describePath(path), expected.Len(), actual.Len(), formatValue(expected), formatValue(actual),
+		)
+	}
+	if !c.opts.IgnoreOrder {
+		for i := 0; i < expected.Len(); i++ {
+			if diff := c.compare(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), actual.Index(i)); diff != "" {
+				return diff
+			}
+		}
+		return ""
+	}
+	used := make([]bool, actual.Len())
+	var missing []int
+	for i := 0; i < expected.Len(); i++ {
+		found := false
+		for j := 0; j < actual.Len(); j++ {
+			if !used[j] && c.compare("", expected.Index(i), actual.Index(j)) == "" {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	var unexpected []int
+	for j, u := range used {
+		if !u {
+			unexpected = append(unexpected, j)
+		}
+	}
+	if len(missing) == 1 {
+		// a single mismatching element: show its difference
+		i, j := missing[0], unexpected[0]
+		return c.compare(fmt.Sprintf("%s[%d]", path, i), expected.Index(i), actual.Index(j))
+	}
+	diff := fmt.Sprintf("%s: %d elements do not match", describePath(path), len(missing))
+	for _, i := range missing {
+		diff += fmt.Sprintf("\n\tmissing in actual   : %v", formatValue(expected.Index(i)))
+	}
+	for _, j := range unexpected {
+		diff += fmt.Sprintf("\n\tunexpected in actual: %v", formatValue(actual.Index(j)))
+	}
+	return diff
+}
+
+// compareMap compares maps by key.
+func (c *comparer) compareMap(path string, expected, actual reflect.Value) string {
+	if expected.IsNil() != actual.IsNil() && (c.strictNil || expected.Len() > 0 || actual.Len() > 0) {
+		return fmt.Sprintf("%s: expected %v, actual %v", describePath(path), formatValue(expected), formatValue(actual))
+	}
+	iter := expected.MapRange()
+	for iter.Next() {
+		key := iter.Key()
+		keyPath := fmt.Sprintf("%s[%v]", path, formatValue(key))
+		actualValue := actual.MapIndex(key)
+		if !actualValue.IsValid() {
+			return fmt.Sprintf("%s: missing in actual", describePath(keyPath))
+		}
+		if diff := c.compare(keyPath, iter.Value(), actualValue); diff != "" {
+			return diff
+		}
+	}
+	if actual.Len() > expected.Len() {
+		iter := actual.MapRange()
+		for iter.Next() {
+			if !expected.MapIndex(iter.Key()).IsValid() {
+				return fmt.Sprintf("%s[%v]: unexpected in actual", describePath(path), formatValue(iter.Key()))
+			}
+		}
+	}
+	return ""
+}
+
+// leafEqual compares values of a kind other than array, slice, map,
+// struct, pointer and interface, including values of unexported fields.
+func leafEqual(expected, actual reflect.Value) bool {
+	switch expected.Kind() {
+	case reflect.Bool:
+		return expected.Bool() == actual.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return expected.Int() == actual.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return expected.Uint() == actual.Uint()
+	case reflect.Float32, reflect.Float64:
+		return expected.Float() == actual.Float()
+	case reflect.Complex64, reflect.Complex128:
+		return expected.Complex() == actual.Complex()
+	case reflect.String:
+		return expected.String() == actual.String()
+	case reflect.Func:
+		return expected.IsNil() && actual.IsNil()
+	case reflect.Chan, reflect.UnsafePointer:
+		return expected.Pointer() == actual.Pointer()
+	}
+	return false
+}
+
+// formatValue formats a value (possibly of an unexported field) for
+// failure messages.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return fmt.Sprintf("%q", v.String())
+	case reflect.Slice, reflect.Map:
+		if v.IsNil() {
+			return fmt.Sprintf("%v(nil)", v.Type())
+		}
+	}
+	return fmt.Sprintf("%+v", v)
+}
+
+// EqualWith asserts that the values are deeply equal, like Equal but
+// without conversion between types, with the comparison configured by
+// opts. The failure message shows the path of the first difference, like
+// "value.Items[2].Name".
+func EqualWith(t TestingT, expected any, actual any, opts EqualOptions, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualWith", expected, actual, opts, msgAndArgs)()
+	}
+	c := &comparer{opts: opts}
+	if diff := c.compare("", reflect.ValueOf(expected), reflect.ValueOf(actual)); diff != "" {
+		a := newAsserter(t, msgAndArgs)
+		a.recordValues(expected, actual)
+		if opts.IgnoreOrder {
+			// indexes in paths are those of expected
+			a.Fail("Not equal (ignoring order of slices): " + diff)
+			return
+		}
+		a.Fail("Not equal: " + diff)
+	}
+}
+
+// EqualUnordered asserts that the values are deeply equal, comparing all
+// arrays and slices, at any depth, as unordered multisets. It is meant for
+// APIs that return sets serialized as arrays.
+func EqualUnordered(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualUnordered", expected, actual, msgAndArgs)()
+	}
+	EqualWith(t, expected, actual, EqualOptions{IgnoreOrder: true}, msgAndArgs...)
+}
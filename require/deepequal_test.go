@@ -0,0 +1,54 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"testing"
+)
+
+func TestEqualUnordered(t *testing.T) {
+	ft := newFakeT(t)
+	EqualUnordered(ft, [][]int{{1, 2}, {3}}, [][]int{{3}, {2, 1}})
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EqualUnordered(ft, []int{1, 1, 2}, []int{1, 2, 2})
+	expectFailed(t, ft, true)
+}
+
+// TestEqualUnorderedPairs checks that a pair of pointers is compared
+// again when it is reached again, as EqualUnordered tries the same pairs
+// for each element of expected.
+func TestEqualUnorderedPairs(t *testing.T) {
+	type node struct{ N int }
+	p, q, r := &node{1}, &node{2}, &node{1}
+
+	ft := newFakeT(t)
+	EqualUnordered(ft, []*node{p, p}, []*node{q, r})
+	expectFailed(t, ft, true)
+
+	ft = newFakeT(t)
+	EqualUnordered(ft, []*node{p, q}, []*node{q, r})
+	expectFailed(t, ft, false)
+}
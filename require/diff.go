@@ -0,0 +1,81 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ilius/demand/internal/diff"
+)
+
+// DiffMode selects how the differences of values are shown in failure
+// messages of assertions such as Equal, see SetDiffMode.
+type DiffMode int
+
+const (
+	// DiffDefault shows multi-line strings and slices as line diffs, and
+	// the keys of maps and fields of structs that differ, each followed
+	// by its expected and actual values.
+	DiffDefault DiffMode = iota
+	// DiffTable shows the differences of structs and maps as a table of
+	// "path | expected | actual" rows, one for each differing leaf value
+	// at any depth, sorted by path, which is easier to scan for wide or
+	// nested structs.
+	DiffTable
+)
+
+var diffMode = DiffDefault
+
+// SetDiffMode sets how the differences of values are shown in failure
+// messages (DiffDefault by default).
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetDiffMode(mode DiffMode) {
+	diffMode = mode
+}
+
+// isStructOrMap reports if v is a struct or map, or a pointer to one.
+func isStructOrMap(v any) bool {
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ != nil && (typ.Kind() == reflect.Struct || typ.Kind() == reflect.Map)
+}
+
+// withDiff appends the differences of expected and actual to the failure
+// message msg, if they can be shown, according to the diff mode.
+func withDiff(msg string, expected, actual any) string {
+	var d string
+	if diffMode == DiffTable && isStructOrMap(expected) && reflect.TypeOf(expected) == reflect.TypeOf(actual) {
+		d = diff.Table(expected, actual)
+	} else {
+		d = diff.Values(expected, actual)
+	}
+	if d != "" {
+		return msg + "\n\nDiff:\n" + strings.TrimSuffix(d, "\n")
+	}
+	return msg
+}
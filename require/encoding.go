@@ -0,0 +1,131 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+)
+
+// decodeBase64 decodes s with the standard padded base64 encoding
+// (RFC 4648 section 4). If that fails but s is valid in another variant,
+// the error says which.
+func decodeBase64(s string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err == nil {
+		return data, nil
+	}
+	variants := []struct {
+		name string
+		enc  *base64.Encoding
+	}{
+		{"unpadded standard", base64.RawStdEncoding},
+		{"URL-safe", base64.URLEncoding},
+		{"unpadded URL-safe", base64.RawURLEncoding},
+	}
+	for _, v := range variants {
+		if _, vErr := v.enc.DecodeString(s); vErr == nil {
+			return nil, fmt.Errorf("%v (valid %s base64)", err, v.name)
+		}
+	}
+	return nil, err
+}
+
+// invalidUTF8Index returns the byte index of the first invalid UTF-8
+// sequence in s, or -1 if s is valid UTF-8.
+func invalidUTF8Index(s []byte) int {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size <= 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
+
+// IsBase64 asserts that s is valid standard base64 (with padding).
+func IsBase64(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsBase64", s, msgAndArgs)()
+	}
+	_, err := decodeBase64(s)
+	validate(t, "base64 string", s, err, msgAndArgs)
+}
+
+// DecodesBase64To asserts that s is valid standard base64 (with padding)
+// that decodes to expected, and returns the decoded bytes.
+func DecodesBase64To(t TestingT, s string, expected []byte, msgAndArgs ...any) []byte {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "DecodesBase64To", s, expected, msgAndArgs)()
+	}
+	data, err := decodeBase64(s)
+	if err != nil {
+		validate(t, "base64 string", s, err, msgAndArgs)
+		return nil
+	}
+	if !bytes.Equal(data, expected) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("%q decodes to unexpected bytes:\nexpected: %q\nactual  : %q", s, expected, data))
+	}
+	return data
+}
+
+// IsHex asserts that s is a valid hexadecimal encoding (an even number of
+// hex digits, in any case).
+func IsHex(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsHex", s, msgAndArgs)()
+	}
+	_, err := hex.DecodeString(s)
+	validate(t, "hex string", s, err, msgAndArgs)
+}
+
+// IsValidUTF8 asserts that s, a string or []byte, is valid UTF-8.
+func IsValidUTF8(t TestingT, s any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsValidUTF8", s, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	var data []byte
+	switch s := s.(type) {
+	case string:
+		data = []byte(s)
+	case []byte:
+		data = s
+	default:
+		a.Fail(fmt.Sprintf("expected a string or []byte, got %T", s))
+		return
+	}
+	if i := invalidUTF8Index(data); i >= 0 {
+		a.Fail(fmt.Sprintf("%q is not valid UTF-8: invalid byte %#x at index %d", data, data[i], i))
+	}
+}
@@ -0,0 +1,247 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// memberErrors returns the errors in err's tree whose messages are not
+// composed of the messages of multiple errors: errors joined by errors.Join
+// (or other errors with Unwrap() []error) and the errors wrapped by them,
+// excluding the joins and the errors wrapping a join.
+func memberErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		members := memberErrors(x.Unwrap())
+		if !hasJoin(x.Unwrap()) {
+			members = append([]error{err}, members...)
+		}
+		return members
+	case interface{ Unwrap() []error }:
+		var members []error
+		for _, member := range x.Unwrap() {
+			members = append(members, memberErrors(member)...)
+		}
+		return members
+	}
+	return []error{err}
+}
+
+// hasJoin reports if err's tree has an error with Unwrap() []error.
+func hasJoin(err error) bool {
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		return hasJoin(x.Unwrap())
+	case interface{ Unwrap() []error }:
+		return true
+	}
+	return false
+}
+
+// errorTree returns err and the errors in its tree, in depth-first order,
+// following both Unwrap() error and Unwrap() []error.
+func errorTree(err error) []error {
+	if err == nil {
+		return nil
+	}
+	tree := []error{err}
+	switch x := err.(type) {
+	case interface{ Unwrap() error }:
+		tree = append(tree, errorTree(x.Unwrap())...)
+	case interface{ Unwrap() []error }:
+		for _, member := range x.Unwrap() {
+			tree = append(tree, errorTree(member)...)
+		}
+	}
+	return tree
+}
+
+// buildErrorTreeString returns the messages of the errors in err's tree,
+// indented by depth, one per line.
+func buildErrorTreeString(err error) string {
+	var sb strings.Builder
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		if err == nil {
+			return
+		}
+		fmt.Fprintf(&sb, "\n%s%q", strings.Repeat("\t", depth+1), err.Error())
+		switch x := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(x.Unwrap(), depth+1)
+		case interface{ Unwrap() []error }:
+			for _, member := range x.Unwrap() {
+				walk(member, depth+1)
+			}
+		}
+	}
+	walk(err, 0)
+	return sb.String()
+}
+
+// JoinedErrorContains asserts that for each of the given substrings, some
+// member error in err's tree has a message containing it, regardless of
+// order. The tree is followed through both Unwrap() error and
+// Unwrap() []error, and the members of errors.Join are checked separately
+// (the joined message itself is not checked).
+func JoinedErrorContains(t TestingT, err error, contains []string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "JoinedErrorContains", err, contains, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if err == nil {
+		a.Fail(fmt.Sprintf("An error is expected but got nil.\nexpected errors containing: %q", contains))
+		return
+	}
+	members := memberErrors(err)
+	var missing []string
+	for _, substr := range contains {
+		found := false
+		for _, member := range members {
+			if strings.Contains(member.Error(), substr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, substr)
+		}
+	}
+	if len(missing) > 0 {
+		a.Fail(fmt.Sprintf("No member error in the tree contains: %q\nerror tree:%s", missing, buildErrorTreeString(err)))
+	}
+}
+
+// JoinedErrorIsAll asserts that each of targets is matched by some error in
+// err's tree (see errors.Is), regardless of order.
+func JoinedErrorIsAll(t TestingT, err error, targets []error, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "JoinedErrorIsAll", err, targets, msgAndArgs)()
+	}
+	var missing []string
+	for _, target := range targets {
+		if !errors.Is(err, target) {
+			missing = append(missing, fmt.Sprintf("%q", fmt.Sprint(target)))
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf(
+		"Target errors should be in err tree:\nmissing: %s\nerror tree:%s",
+		strings.Join(missing, ", "), buildErrorTreeString(err),
+	))
+}
+
+// ErrorCodeFunc returns the code of err and true, or false if err (itself,
+// not its chain) has no code.
+type ErrorCodeFunc func(err error) (code any, ok bool)
+
+// errorCodeFunc is used by ErrorCodeIs, see SetErrorCodeFunc.
+var errorCodeFunc ErrorCodeFunc = defaultErrorCode
+
+// SetErrorCodeFunc changes the function used by ErrorCodeIs to find the
+// code of errors, for codebases whose coded errors have another interface.
+// Passing nil restores the default, which uses a Code() method returning
+// a string or an integer.
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetErrorCodeFunc(fn ErrorCodeFunc) {
+	if fn == nil {
+		fn = defaultErrorCode
+	}
+	errorCodeFunc = fn
+}
+
+// defaultErrorCode returns the result of Code() method of err, if it takes
+// no arguments and returns a string or an integer (of any kind).
+func defaultErrorCode(err error) (any, bool) {
+	method := reflect.ValueOf(err).MethodByName("Code")
+	if !method.IsValid() {
+		return nil, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 {
+		return nil, false
+	}
+	switch methodType.Out(0).Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return method.Call(nil)[0].Interface(), true
+	}
+	return nil, false
+}
+
+// ErrorCodeIs asserts that the first error in err's chain that has a code
+// has the given code. By default, the code of an error is given by its
+// Code() method returning a string or an integer, see SetErrorCodeFunc.
+// Codes of different integer types are compared by value, so code can be
+// an untyped constant.
+func ErrorCodeIs(t TestingT, err error, code any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ErrorCodeIs", err, code, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if err == nil {
+		a.Fail(fmt.Sprintf("An error is expected but got nil.\nexpected error with code: %s", formatCode(code)))
+		return
+	}
+	for _, e := range errorTree(err) {
+		actual, ok := errorCodeFunc(e)
+		if !ok {
+			continue
+		}
+		if !isEqualConverted(actual, code) {
+			a.Fail(fmt.Sprintf(
+				"Error code mismatch:\nexpected: %s\nactual  : %s\nerror: %q",
+				formatCode(code), formatCode(actual), e.Error(),
+			))
+		}
+		return
+	}
+	a.Fail(fmt.Sprintf(
+		"No error with code in the chain, expected code: %s\nin chain: %s",
+		formatCode(code), buildErrorChainString(err),
+	))
+}
+
+// formatCode formats an error code for failure messages, quoting strings.
+func formatCode(code any) string {
+	if s, ok := code.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprint(code)
+}
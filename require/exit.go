@@ -0,0 +1,163 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// exitEnv is the environment variable that tells a re-executed test binary
+// which ExitsWith call to run: the test name and the index of the call in
+// the test, like "TestMain/sub#2".
+const exitEnv = "DEMAND_EXITS_WITH"
+
+// exitReturnedMarker is written to stderr by the subprocess if the function
+// given to ExitsWith returns instead of exiting.
+const exitReturnedMarker = "demand: ExitsWith function returned without exiting"
+
+// exitCalls counts the ExitsWith calls of each running test, by its
+// TestingT (unwrapped, see rootT), so that the calls are counted again when
+// the test runs again, such as with -count.
+var exitCalls = struct {
+	sync.Mutex
+	count map[TestingT]int
+}{count: map[TestingT]int{}}
+
+// rootT returns the TestingT wrapped by t, through all the TestingT
+// wrappers of this package.
+func rootT(t TestingT) TestingT {
+	for inner := innerT(t); inner != nil; inner = innerT(inner) {
+		t = inner
+	}
+	return t
+}
+
+// nextExitCall returns the identifier of this ExitsWith call in the test:
+// its name and the number of ExitsWith calls in it so far.
+func nextExitCall(t TestingT) string {
+	t = rootT(t)
+	exitCalls.Lock()
+	defer exitCalls.Unlock()
+	if _, ok := exitCalls.count[t]; !ok {
+		t.Cleanup(func() {
+			exitCalls.Lock()
+			delete(exitCalls.count, t)
+			exitCalls.Unlock()
+		})
+	}
+	exitCalls.count[t]++
+	return t.Name() + "#" + strconv.Itoa(exitCalls.count[t])
+}
+
+// testRunPattern returns a -test.run pattern that matches only the test
+// (or subtest) with the given name.
+func testRunPattern(name string) string {
+	parts := strings.Split(name, "/")
+	for i, part := range parts {
+		parts[i] = "^" + regexp.QuoteMeta(part) + "$"
+	}
+	return strings.Join(parts, "/")
+}
+
+// ExitsWith asserts that f terminates the process with the expected exit
+// code, typically by calling os.Exit or log.Fatal.
+//
+// It re-executes the test binary in a subprocess that runs only the
+// current test, which calls f at the same ExitsWith call (identified by
+// the number of ExitsWith calls before it in the test). The code of the
+// test before that call runs again in the subprocess, and must take the
+// same path, but the other ExitsWith calls do nothing there.
+func ExitsWith(t TestingT, expectedCode int, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ExitsWith", expectedCode, f, msgAndArgs)()
+	}
+	exitsWith(t, expectedCode, nil, f, msgAndArgs)
+}
+
+// ExitsWithStderr is like ExitsWith, but also asserts that the standard
+// error of the process contains stderrContains.
+func ExitsWithStderr(t TestingT, expectedCode int, stderrContains string, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ExitsWithStderr", expectedCode, stderrContains, f, msgAndArgs)()
+	}
+	exitsWith(t, expectedCode, &stderrContains, f, msgAndArgs)
+}
+
+func exitsWith(t TestingT, expectedCode int, stderrContains *string, f func(), msgAndArgs []any) {
+	t.Helper()
+	call := nextExitCall(t)
+	if env, ok := os.LookupEnv(exitEnv); ok {
+		if env != call {
+			return
+		}
+		f()
+		fmt.Fprintln(os.Stderr, exitReturnedMarker)
+		os.Exit(0)
+	}
+
+	a := newAsserter(t, msgAndArgs)
+	cmd := exec.Command(os.Args[0], "-test.run="+testRunPattern(t.Name()))
+	cmd.Env = append(os.Environ(), exitEnv+"="+call)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	code := 0
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			a.Fail(fmt.Sprintf("failed to run the test binary: %v", err))
+			return
+		}
+		code = exitErr.ExitCode()
+	}
+
+	output := stderr.String()
+	switch {
+	case strings.Contains(output, exitReturnedMarker):
+		output = strings.Replace(output, exitReturnedMarker+"\n", "", 1)
+		a.Fail(fmt.Sprintf("expected the function to exit with code %d, but it returned\nstderr:\n%s", expectedCode, tail(output)))
+	case code != expectedCode:
+		a.Fail(fmt.Sprintf("expected exit code %d, got %d\nstderr:\n%s", expectedCode, code, tail(output)))
+	case stderrContains != nil && !strings.Contains(output, *stderrContains):
+		a.Fail(fmt.Sprintf("expected stderr to contain %q\nstderr:\n%s", *stderrContains, tail(output)))
+	}
+}
+
+// tail returns the last maxDumpedBody bytes of s.
+func tail(s string) string {
+	if len(s) > maxDumpedBody {
+		return "..." + s[len(s)-maxDumpedBody:]
+	}
+	return s
+}
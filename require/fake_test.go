@@ -0,0 +1,98 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeT is a TestingT recording the failures of assertions instead of
+// reporting them, for testing the assertions themselves. Like Soft, fatal
+// failures do not stop the caller.
+type fakeT struct {
+	testing.TB
+	mu   sync.Mutex
+	msgs []string
+	fail bool
+}
+
+func newFakeT(t testing.TB) *fakeT {
+	return &fakeT{TB: t}
+}
+
+func (t *fakeT) record(msg string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.fail = true
+	if msg != "" {
+		t.msgs = append(t.msgs, msg)
+	}
+}
+
+func (t *fakeT) Error(args ...any)                 { t.record(fmt.Sprint(args...)) }
+func (t *fakeT) Errorf(format string, args ...any) { t.record(fmt.Sprintf(format, args...)) }
+func (t *fakeT) Fatal(args ...any)                 { t.record(fmt.Sprint(args...)) }
+func (t *fakeT) Fatalf(format string, args ...any) { t.record(fmt.Sprintf(format, args...)) }
+func (t *fakeT) Fail()                             { t.record("") }
+func (t *fakeT) FailNow()                          { t.record("") }
+
+func (t *fakeT) Failed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.fail
+}
+
+// message returns the recorded failure messages.
+func (t *fakeT) message() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return strings.Join(t.msgs, "\n")
+}
+
+// expectFailed reports an error on t if ft failed and failed is false, or
+// the other way around.
+func expectFailed(t *testing.T, ft *fakeT, failed bool) {
+	t.Helper()
+	switch {
+	case failed && !ft.Failed():
+		t.Error("expected the assertion to fail")
+	case !failed && ft.Failed():
+		t.Errorf("expected the assertion to pass, it failed with:\n%s", ft.message())
+	}
+}
+
+// expectMessage reports an error on t if the failure messages of ft do not
+// contain all the given strings.
+func expectMessage(t *testing.T, ft *fakeT, contains ...string) {
+	t.Helper()
+	msg := ft.message()
+	for _, s := range contains {
+		if !strings.Contains(msg, s) {
+			t.Errorf("expected the failure message to contain %q, got:\n%s", s, msg)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"strings"
+)
+
+// The functions of this file compare and inspect values of common types
+// without reflection, since reflect.DeepEqual dominates the cost of tests
+// with many assertions. They report ok = false for other types, which are
+// handled by the generic (reflection based) code, and must give the same
+// results as that code.
+
+// equalFast compares values of the same basic type, or []byte.
+func equalFast(actual, expected any) (equal, ok bool) {
+	switch actual := actual.(type) {
+	case string:
+		e, ok := expected.(string)
+		return ok && actual == e, ok
+	case bool:
+		e, ok := expected.(bool)
+		return ok && actual == e, ok
+	case int:
+		e, ok := expected.(int)
+		return ok && actual == e, ok
+	case int8:
+		e, ok := expected.(int8)
+		return ok && actual == e, ok
+	case int16:
+		e, ok := expected.(int16)
+		return ok && actual == e, ok
+	case int32:
+		e, ok := expected.(int32)
+		return ok && actual == e, ok
+	case int64:
+		e, ok := expected.(int64)
+		return ok && actual == e, ok
+	case uint:
+		e, ok := expected.(uint)
+		return ok && actual == e, ok
+	case uint8:
+		e, ok := expected.(uint8)
+		return ok && actual == e, ok
+	case uint16:
+		e, ok := expected.(uint16)
+		return ok && actual == e, ok
+	case uint32:
+		e, ok := expected.(uint32)
+		return ok && actual == e, ok
+	case uint64:
+		e, ok := expected.(uint64)
+		return ok && actual == e, ok
+	case float32:
+		e, ok := expected.(float32)
+		return ok && actual == e, ok
+	case float64:
+		e, ok := expected.(float64)
+		return ok && actual == e, ok
+	case []byte:
+		e, ok := expected.([]byte)
+		// like reflect.DeepEqual, nil and empty slices are different
+		return ok && (actual == nil) == (e == nil) && bytes.Equal(actual, e), ok
+	}
+	return false, false
+}
+
+// containsFast checks if a string or []byte contains a substring or rune,
+// or a []string or []int contains an element.
+func containsFast(s any, element any) (found, ok bool) {
+	switch s := s.(type) {
+	case string:
+		switch element := element.(type) {
+		case string:
+			return strings.Contains(s, element), true
+		case rune:
+			return strings.ContainsRune(s, element), true
+		}
+	case []byte:
+		switch element := element.(type) {
+		case []byte:
+			return bytes.Contains(s, element), true
+		case string:
+			return bytes.Contains(s, []byte(element)), true
+		case byte:
+			return bytes.IndexByte(s, element) >= 0, true
+		}
+	case []string:
+		if element, isString := element.(string); isString {
+			for _, e := range s {
+				if e == element {
+					return true, true
+				}
+			}
+			return false, true
+		}
+	case []int:
+		if element, isInt := element.(int); isInt {
+			for _, e := range s {
+				if e == element {
+					return true, true
+				}
+			}
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// isEmptyFast checks if a string, bool, []byte or common numeric value is
+// empty.
+func isEmptyFast(object any) (empty, ok bool) {
+	switch object := object.(type) {
+	case nil:
+		return true, true
+	case string:
+		return object == "", true
+	case bool:
+		return !object, true
+	case int:
+		return object == 0, true
+	case int64:
+		return object == 0, true
+	case uint:
+		return object == 0, true
+	case uint64:
+		return object == 0, true
+	case float64:
+		return object == 0, true
+	case []byte:
+		return len(object) == 0, true
+	}
+	return false, false
+}
+
+// isNilFast checks if a value of a basic type is nil, which it never is.
+func isNilFast(object any) (isNil, ok bool) {
+	switch object.(type) {
+	case nil:
+		return true, true
+	case string, bool,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64:
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,148 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// filePollInterval is the interval of checks of EventuallyFileExists
+	// and EventuallyFileContains.
+	filePollInterval = 10 * time.Millisecond
+
+	// maxListedFiles is the maximum number of directory entries listed in
+	// failure messages.
+	maxListedFiles = 50
+)
+
+// listDir describes the entries of the directory dir for failure messages.
+func listDir(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Sprintf("directory %q: %v", dir, err)
+	}
+	if len(entries) == 0 {
+		return fmt.Sprintf("directory %q is empty", dir)
+	}
+	lines := []string{fmt.Sprintf("directory %q:", dir)}
+	for i, entry := range entries {
+		if i == maxListedFiles {
+			lines = append(lines, fmt.Sprintf("\t... and %d more", len(entries)-maxListedFiles))
+			break
+		}
+		info, err := entry.Info()
+		switch {
+		case err != nil:
+			lines = append(lines, fmt.Sprintf("\t%s (%v)", entry.Name(), err))
+		case entry.IsDir():
+			lines = append(lines, "\t"+entry.Name()+"/")
+		default:
+			lines = append(lines, fmt.Sprintf("\t%s (%d bytes)", entry.Name(), info.Size()))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// EventuallyFileExists asserts that the file at path exists within
+// timeout, for files produced asynchronously, such as by log writers or
+// file watchers. On failure, the entries of its directory are listed.
+// Like Eventually, timeout is capped at the test deadline.
+func EventuallyFileExists(t TestingT, path string, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyFileExists", path, timeout, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	timeout, capped := capToDeadline(t, span.capWait(timeout))
+	exists := func() bool {
+		info, err := os.Stat(path)
+		return err == nil && !info.IsDir()
+	}
+	if exists() || pollCondition(findClock(t), exists, timeout, filePollInterval) {
+		span.end(a)
+		return
+	}
+	if !span.end(a) {
+		return
+	}
+	reason := fmt.Sprintf("file %q does not exist after %v", path, timeout)
+	if capped {
+		reason = fmt.Sprintf("test deadline would be exceeded: file %q does not exist after %v before the deadline", path, timeout)
+	}
+	a.Fail(reason + "\n" + listDir(filepath.Dir(path)))
+}
+
+// EventuallyFileContains asserts that the file at path exists and
+// contains the string contains within timeout, for files written
+// asynchronously, such as logs that are flushed periodically. On failure,
+// the last content of the file (its beginning if large), or the entries of
+// its directory if it does not exist, are shown.
+// Like Eventually, timeout is capped at the test deadline.
+func EventuallyFileContains(t TestingT, path string, contains string, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyFileContains", path, contains, timeout, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	timeout, capped := capToDeadline(t, span.capWait(timeout))
+	check := func() bool {
+		content, err := os.ReadFile(path)
+		return err == nil && bytes.Contains(content, []byte(contains))
+	}
+	if check() || pollCondition(findClock(t), check, timeout, filePollInterval) {
+		span.end(a)
+		return
+	}
+	if !span.end(a) {
+		return
+	}
+	reason := fmt.Sprintf("file %q does not contain %q after %v", path, contains, timeout)
+	if capped {
+		reason = fmt.Sprintf("test deadline would be exceeded: file %q does not contain %q after %v before the deadline", path, contains, timeout)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		a.Fail(fmt.Sprintf("%s: %v\n%s", reason, err, listDir(filepath.Dir(path))))
+		return
+	}
+	if len(content) > largeValueLen {
+		a.Fail(fmt.Sprintf("%s\ncontent (%d bytes, first %d shown):\n%s", reason, len(content), largeValueLen, content[:largeValueLen]))
+		return
+	}
+	a.Fail(fmt.Sprintf("%s\ncontent (%d bytes):\n%s", reason, len(content), content))
+}
@@ -0,0 +1,97 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FlakyOptions are the options of FlakyWith.
+type FlakyOptions struct {
+	// Delay is the time to wait between attempts.
+	Delay time.Duration
+}
+
+// Flaky runs f up to attempts times, until an attempt passes, as a
+// quarantine for a flaky test (or part of it) while its cause is found:
+//
+//	require.Flaky(t, 3, func(t require.TestingT) {
+//		resp := fetchStatus(t)
+//		require.Equal(t, "ready", resp.State)
+//	})
+//
+// f is called with a TestingT that records failures instead of reporting
+// them, and stops the attempt on fatal failures, so FailNow (and fatal
+// assertions) must only be called from the goroutine running f. Other
+// methods, such as Cleanup and TempDir, are those of t.
+//
+// If the last attempt also fails, the failures of all attempts are
+// reported. If an attempt passes after failed ones, their failures are
+// logged, so that the flakiness stays visible.
+func Flaky(t TestingT, attempts int, f func(t TestingT), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Flaky", attempts, f, msgAndArgs)()
+	}
+	FlakyWith(t, attempts, FlakyOptions{}, f, msgAndArgs...)
+}
+
+// FlakyWith is like Flaky, with options.
+func FlakyWith(t TestingT, attempts int, opts FlakyOptions, f func(t TestingT), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FlakyWith", attempts, opts, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if attempts < 1 {
+		a.failf("Flaky needs at least 1 attempt, got %d", attempts)
+		return
+	}
+	var failures []string
+	for i := 1; i <= attempts; i++ {
+		if i > 1 && opts.Delay > 0 {
+			time.Sleep(opts.Delay)
+		}
+		failure := (&collectT{TestingT: t, stop: true}).run(f)
+		if failure == "" {
+			if len(failures) > 0 {
+				t.Logf("passed on attempt %d of %d, after failures:%s", i, attempts, formatAttempts(failures))
+			}
+			return
+		}
+		failures = append(failures, failure)
+	}
+	a.Fail(fmt.Sprintf("all %d attempts failed:%s", attempts, formatAttempts(failures)))
+}
+
+// formatAttempts lists the failures of attempts of Flaky.
+func formatAttempts(failures []string) string {
+	var sb strings.Builder
+	for i, failure := range failures {
+		fmt.Fprintf(&sb, "\n\nattempt %d:\n\t%s", i+1, strings.ReplaceAll(failure, "\n", "\n\t"))
+	}
+	return sb.String()
+}
@@ -0,0 +1,291 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// maxListedElements is the maximum number of mismatching elements listed
+// in failure messages of slice and matrix assertions.
+const maxListedElements = 10
+
+// floatMismatch is an element of a float slice or matrix that is not
+// within delta of the expected value.
+type floatMismatch struct {
+	index    string
+	expected float64
+	actual   float64
+}
+
+// inDelta reports if actual is within delta of expected, where NaN is
+// only within delta of NaN.
+func inDelta(expected, actual, delta float64) bool {
+	if math.IsNaN(expected) || math.IsNaN(actual) {
+		return math.IsNaN(expected) && math.IsNaN(actual)
+	}
+	return math.Abs(expected-actual) <= delta
+}
+
+// describeFloatMismatches lists the first maxListedElements mismatches,
+// with their differences, for failure messages.
+func describeFloatMismatches(mismatches []floatMismatch, total int, delta float64) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d of %d elements differ by more than %v:", len(mismatches), total, delta)
+	for i, m := range mismatches {
+		if i == maxListedElements {
+			fmt.Fprintf(&sb, "\n\t... and %d more", len(mismatches)-i)
+			break
+		}
+		fmt.Fprintf(
+			&sb, "\n\t%s: expected %v, actual %v, difference %v",
+			m.index, m.expected, m.actual, math.Abs(m.expected-m.actual),
+		)
+	}
+	return sb.String()
+}
+
+// FloatSliceInDelta asserts that the slices have the same length, and that
+// each element of actual is within delta of the element of expected with
+// the same index (NaN is only within delta of NaN). The failure message
+// lists the indexes that exceed the tolerance, with their differences.
+func FloatSliceInDelta(t TestingT, expected []float64, actual []float64, delta float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FloatSliceInDelta", expected, actual, delta, msgAndArgs)()
+	}
+	if len(expected) != len(actual) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("length mismatch: expected %d elements, actual %d", len(expected), len(actual)))
+		return
+	}
+	var mismatches []floatMismatch
+	for i, e := range expected {
+		if !inDelta(e, actual[i], delta) {
+			mismatches = append(mismatches, floatMismatch{fmt.Sprintf("[%d]", i), e, actual[i]})
+		}
+	}
+	if len(mismatches) > 0 {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(describeFloatMismatches(mismatches, len(expected), delta))
+	}
+}
+
+// MatrixInDelta asserts that the matrices (slices of rows) have the same
+// shape, and that each element of actual is within delta of the element of
+// expected at the same row and column (NaN is only within delta of NaN).
+// A shape mismatch (number of rows, or length of a row) is reported
+// without comparing values. Otherwise, the failure message lists the
+// cells that exceed the tolerance, as [row][column], with their
+// differences.
+func MatrixInDelta(t TestingT, expected [][]float64, actual [][]float64, delta float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "MatrixInDelta", expected, actual, delta, msgAndArgs)()
+	}
+	if len(expected) != len(actual) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("shape mismatch: expected %d rows, actual %d", len(expected), len(actual)))
+		return
+	}
+	for i := range expected {
+		if len(expected[i]) != len(actual[i]) {
+			a := newAsserter(t, msgAndArgs)
+			a.Fail(fmt.Sprintf("shape mismatch: expected %d columns in row %d, actual %d", len(expected[i]), i, len(actual[i])))
+			return
+		}
+	}
+	var mismatches []floatMismatch
+	total := 0
+	for i, row := range expected {
+		total += len(row)
+		for j, e := range row {
+			if !inDelta(e, actual[i][j], delta) {
+				mismatches = append(mismatches, floatMismatch{fmt.Sprintf("[%d][%d]", i, j), e, actual[i][j]})
+			}
+		}
+	}
+	if len(mismatches) > 0 {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(describeFloatMismatches(mismatches, total, delta))
+	}
+}
+
+// InDeltaSlice asserts that the arrays or slices of numbers have the same
+// length, and that each element of actual is within delta of the element
+// of expected with the same index, see InDelta.
+func InDeltaSlice(t TestingT, expected any, actual any, delta float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "InDeltaSlice", expected, actual, delta, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !isListKind(expectedValue.Kind()) || !isListKind(actualValue.Kind()) {
+		a.Fail("Parameters must be slice")
+		return
+	}
+	if expectedValue.Len() != actualValue.Len() {
+		a.Fail(fmt.Sprintf("lengths differ: expected %d, actual %d", expectedValue.Len(), actualValue.Len()))
+		return
+	}
+	for i := 0; i < expectedValue.Len(); i++ {
+		if msg, ok := checkInDelta(expectedValue.Index(i).Interface(), actualValue.Index(i).Interface(), delta); !ok {
+			a.Fail(fmt.Sprintf("[%d]: %s", i, msg))
+			return
+		}
+	}
+}
+
+// InDeltaMapValues asserts that the maps have the same keys, and that the
+// value of each key in actual is within delta of its value in expected,
+// see InDelta.
+func InDeltaMapValues(t TestingT, expected any, actual any, delta float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "InDeltaMapValues", expected, actual, delta, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if expectedValue.Kind() != reflect.Map || actualValue.Kind() != reflect.Map {
+		a.Fail("Arguments must be maps")
+		return
+	}
+	if expectedValue.Type().Key() != actualValue.Type().Key() {
+		a.Fail(fmt.Sprintf("key types %v and %v are different", expectedValue.Type().Key(), actualValue.Type().Key()))
+		return
+	}
+	if expectedValue.Len() != actualValue.Len() {
+		a.Fail("Arguments must have the same number of keys")
+		return
+	}
+	iter := expectedValue.MapRange()
+	for iter.Next() {
+		value := actualValue.MapIndex(iter.Key())
+		if !value.IsValid() {
+			a.Fail(fmt.Sprintf("missing key %#v in actual map", iter.Key().Interface()))
+			return
+		}
+		if msg, ok := checkInDelta(iter.Value().Interface(), value.Interface(), delta); !ok {
+			a.Fail(fmt.Sprintf("[%#v]: %s", iter.Key().Interface(), msg))
+			return
+		}
+	}
+}
+
+// InEpsilon asserts that expected and actual have a relative error less
+// than epsilon, which is |expected - actual| / |expected|, so expected must
+// not be zero.
+func InEpsilon(t TestingT, expected any, actual any, epsilon float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "InEpsilon", expected, actual, epsilon, msgAndArgs)()
+	}
+	if msg, ok := checkInEpsilon(expected, actual, epsilon); !ok {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(msg)
+	}
+}
+
+// InEpsilonSlice asserts that the arrays or slices of numbers have the
+// same length, and that each element of actual has a relative error less
+// than epsilon with the element of expected with the same index, see
+// InEpsilon.
+func InEpsilonSlice(t TestingT, expected any, actual any, epsilon float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "InEpsilonSlice", expected, actual, epsilon, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedValue, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !isListKind(expectedValue.Kind()) || !isListKind(actualValue.Kind()) {
+		a.Fail("Parameters must be slice")
+		return
+	}
+	if expectedValue.Len() != actualValue.Len() {
+		a.Fail(fmt.Sprintf("lengths differ: expected %d, actual %d", expectedValue.Len(), actualValue.Len()))
+		return
+	}
+	for i := 0; i < expectedValue.Len(); i++ {
+		if msg, ok := checkInEpsilon(expectedValue.Index(i).Interface(), actualValue.Index(i).Interface(), epsilon); !ok {
+			a.Fail(fmt.Sprintf("[%d]: %s", i, msg))
+			return
+		}
+	}
+}
+
+// isListKind reports if kind is the kind of arrays or slices.
+func isListKind(kind reflect.Kind) bool {
+	return kind == reflect.Array || kind == reflect.Slice
+}
+
+// checkInDelta returns the failure message of InDelta if the numbers are
+// not within delta of each other.
+func checkInDelta(expected, actual any, delta float64) (string, bool) {
+	af, aok := toFloat(expected)
+	bf, bok := toFloat(actual)
+	switch {
+	case !aok || !bok:
+		return "Parameters must be numerical", false
+	case math.IsNaN(af) && math.IsNaN(bf):
+		return "", true
+	case math.IsNaN(af):
+		return "Expected must not be NaN", false
+	case math.IsNaN(bf):
+		return fmt.Sprintf("Expected %v with delta %v, but was NaN", expected, delta), false
+	}
+	if dt := af - bf; dt < -delta || dt > delta {
+		return fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt), false
+	}
+	return "", true
+}
+
+// checkInEpsilon returns the failure message of InEpsilon if the relative
+// error of the numbers is not less than epsilon.
+func checkInEpsilon(expected, actual any, epsilon float64) (string, bool) {
+	if math.IsNaN(epsilon) {
+		return "epsilon must not be NaN", false
+	}
+	af, aok := toFloat(expected)
+	bf, bok := toFloat(actual)
+	switch {
+	case !aok || !bok:
+		return "Parameters must be numerical", false
+	case math.IsNaN(af) && math.IsNaN(bf):
+		return "", true
+	case math.IsNaN(af):
+		return "expected value must not be NaN", false
+	case af == 0:
+		return "expected value must have a value other than zero to calculate the relative error", false
+	case math.IsNaN(bf):
+		return "actual value must not be NaN", false
+	}
+	if relative := math.Abs(af-bf) / math.Abs(af); relative > epsilon {
+		return fmt.Sprintf("Relative error is too high: %#v (expected)\n        < %#v (actual)", epsilon, relative), false
+	}
+	return "", true
+}
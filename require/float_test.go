@@ -0,0 +1,76 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"math"
+	"testing"
+)
+
+func TestInEpsilon(t *testing.T) {
+	tests := []struct {
+		expected, actual any
+		epsilon          float64
+		failed           bool
+	}{
+		{100, 101, 0.02, false},
+		{100, 110, 0.02, true},
+		{int8(-10), 10.5, 2.1, false},
+		{0, 0, 0.1, true},
+		{math.NaN(), math.NaN(), 0.1, false},
+		{1.0, math.NaN(), 0.1, true},
+		{"1", 1, 0.1, true},
+	}
+	for _, tt := range tests {
+		ft := newFakeT(t)
+		InEpsilon(ft, tt.expected, tt.actual, tt.epsilon)
+		if ft.Failed() != tt.failed {
+			t.Errorf("InEpsilon(%v, %v, %v) failed = %v, expected %v", tt.expected, tt.actual, tt.epsilon, ft.Failed(), tt.failed)
+		}
+	}
+
+	ft := newFakeT(t)
+	InEpsilonSlice(ft, []float64{100, 200}, []float64{101, 260}, 0.1)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "[1]: Relative error is too high")
+}
+
+func TestInDeltaSliceAndMapValues(t *testing.T) {
+	ft := newFakeT(t)
+	InDeltaSlice(ft, []float64{1, 2}, []int{1, 3}, 1)
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	InDeltaSlice(ft, []float64{1, 2}, []float64{1}, 1)
+	expectFailed(t, ft, true)
+
+	ft = newFakeT(t)
+	InDeltaMapValues(ft, map[string]float64{"a": 1}, map[string]float64{"a": 1.05}, 0.1)
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	InDeltaMapValues(ft, map[string]float64{"a": 1}, map[string]float64{"b": 1}, 0.1)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "missing key")
+}
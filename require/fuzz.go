@@ -0,0 +1,93 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// Assume skips the test if condition is false.
+// It is meant for fuzz targets, to skip inputs that are not valid for the
+// code under test, instead of failing (and recording them as a crash):
+//
+//	f.Fuzz(func(t *testing.T, s string) {
+//		require.Assume(t, utf8.ValidString(s))
+//		...
+//	})
+func Assume(t TestingT, condition bool, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Assume", condition, msgAndArgs)()
+	}
+	if condition {
+		return
+	}
+	t.Skip("invalid input: " + messageFromMsgAndArgs("assumption is false", msgAndArgs))
+}
+
+// AssumeNoError skips the test if err is not nil.
+// Like Assume, it is meant for skipping invalid inputs of fuzz targets,
+// for example when the input fails to parse.
+func AssumeNoError(t TestingT, err error, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "AssumeNoError", err, msgAndArgs)()
+	}
+	if err == nil {
+		return
+	}
+	t.Skip("invalid input: " + messageFromMsgAndArgs(err.Error(), msgAndArgs))
+}
+
+// AddSeeds adds each seed to the seed corpus of a fuzz test with a single
+// argument.
+func AddSeeds[T any](f *testing.F, seeds ...T) {
+	f.Helper()
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+}
+
+// AddSeedFiles adds the content of each file matching the pattern (see
+// filepath.Glob) to the seed corpus of a fuzz test with a single []byte
+// argument. It fails if no file matches the pattern.
+func AddSeedFiles(f *testing.F, pattern string) {
+	f.Helper()
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		f.Fatalf("invalid seed file pattern %q: %v", pattern, err)
+	}
+	if len(paths) == 0 {
+		f.Fatalf("no seed files matching %q", pattern)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			f.Fatalf("error reading seed file: %v", err)
+		}
+		f.Add(data)
+	}
+}
@@ -0,0 +1,122 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ilius/demand/internal/diff"
+)
+
+// updateFlag is the -demand.update flag, which is only registered in test
+// binaries, so that importing the package (such as by check) does not add
+// a flag to programs. It is registered by init, since the flags of a test
+// binary are parsed before the tests run.
+var updateFlag *bool
+
+func init() {
+	if testing.Testing() {
+		updateFlag = flag.Bool("demand.update", false, "update golden files of demand/require instead of comparing with them")
+	}
+}
+
+// GoldenOptions configures the golden file assertions.
+type GoldenOptions struct {
+	// Update writes the actual content to the golden file, instead of
+	// comparing. It is also enabled by -demand.update flag of go test.
+	Update bool
+	// Raw disables the normalization of content by content type, such as
+	// pretty-printing JSON with sorted keys, before writing and comparing.
+	Raw bool
+}
+
+// isJSONContentType reports if the media type of the Content-Type header
+// value is JSON, like application/json or application/problem+json.
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// normalizeJSON returns data pretty-printed with sorted object keys, or
+// data itself if it is not valid JSON.
+func normalizeJSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return data
+	}
+	normalized, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return data
+	}
+	return append(normalized, '\n')
+}
+
+// matchGolden compares actual with the content of the golden file, or
+// writes it to the golden file if updating.
+func matchGolden(a *asserter, goldenPath string, actual []byte, opts GoldenOptions, normalize func([]byte) []byte) {
+	a.t.Helper()
+	if !opts.Raw && normalize != nil {
+		actual = normalize(actual)
+	}
+	if opts.Update || (updateFlag != nil && *updateFlag) {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0o755); err != nil {
+			a.Fail(fmt.Sprintf("could not create directory of golden file: %v", err))
+			return
+		}
+		if err := os.WriteFile(goldenPath, actual, 0o644); err != nil {
+			a.Fail(fmt.Sprintf("could not write golden file: %v", err))
+			return
+		}
+		a.t.Logf("updated golden file %s", goldenPath)
+		return
+	}
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		a.Fail(fmt.Sprintf("could not read golden file: %v\n(run go test with -demand.update to create it)", err))
+		return
+	}
+	if !opts.Raw && normalize != nil {
+		expected = normalize(expected)
+	}
+	if bytes.Equal(expected, actual) {
+		return
+	}
+	a.Fail(fmt.Sprintf(
+		"content does not match golden file %s (run go test with -demand.update to update it):\n%s",
+		goldenPath, diff.Unified(goldenPath, "actual", string(expected), string(actual)),
+	))
+}
@@ -0,0 +1,87 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"strings"
+)
+
+// prefixT is a TestingT that prefixes failure messages with the labels of
+// the enclosing groups, see Group.
+type prefixT struct {
+	TestingT
+	labels []string
+}
+
+func (p *prefixT) prefix(msg string) string {
+	return strings.Join(p.labels, " > ") + ": " + msg
+}
+
+func (p *prefixT) Error(args ...any) {
+	p.TestingT.Helper()
+	p.TestingT.Error(p.prefix(fmt.Sprint(args...)))
+}
+
+func (p *prefixT) Errorf(format string, args ...any) {
+	p.TestingT.Helper()
+	p.TestingT.Error(p.prefix(fmt.Sprintf(format, args...)))
+}
+
+func (p *prefixT) Fatal(args ...any) {
+	p.TestingT.Helper()
+	p.TestingT.Fatal(p.prefix(fmt.Sprint(args...)))
+}
+
+func (p *prefixT) Fatalf(format string, args ...any) {
+	p.TestingT.Helper()
+	p.TestingT.Fatal(p.prefix(fmt.Sprintf(format, args...)))
+}
+
+// withLabel returns t with label added to the prefix of failure messages.
+func withLabel(t TestingT, label string) TestingT {
+	if p, ok := t.(*prefixT); ok {
+		labels := append(append([]string{}, p.labels...), label)
+		return &prefixT{TestingT: p.TestingT, labels: labels}
+	}
+	return &prefixT{TestingT: t, labels: []string{label}}
+}
+
+// Group calls f with Assertions whose failure messages are prefixed with
+// label, and the labels of the enclosing groups, like
+// "checking user > validating response headers: ...".
+//
+//	require.Group(t, "validating response headers", func(r *require.Assertions) {
+//		r.Equal("no-cache", resp.Header.Get("Cache-Control"))
+//		r.Group("content type", func(r *require.Assertions) {
+//			r.ContentType(resp.Header.Get("Content-Type"), "application/json", nil)
+//		})
+//	})
+func Group(t TestingT, label string, f func(r *Assertions)) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Group", label, f)()
+	}
+	f(&Assertions{t: withLabel(t, label)})
+}
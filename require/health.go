@@ -0,0 +1,202 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Probe checks if a dependency of a test, such as a server or a container,
+// is ready, see EventuallyHealthy.
+type Probe interface {
+	// Check returns nil if the dependency is ready, or the reason it is not.
+	Check(ctx context.Context) error
+	// String describes the probe, such as "tcp localhost:5432".
+	String() string
+}
+
+// funcProbe is a Probe made of a function, see NewProbe.
+type funcProbe struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (p *funcProbe) Check(ctx context.Context) error {
+	return p.check(ctx)
+}
+
+func (p *funcProbe) String() string {
+	return p.name
+}
+
+// NewProbe returns a Probe with the given name, calling check.
+func NewProbe(name string, check func(ctx context.Context) error) Probe {
+	return &funcProbe{name: name, check: check}
+}
+
+// HTTPProbe returns a Probe sending GET requests to url, which is ready
+// when the response has a 2xx status code.
+func HTTPProbe(url string) Probe {
+	return NewProbe("http "+url, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("status code %d", resp.StatusCode)
+		}
+		return nil
+	})
+}
+
+// TCPProbe returns a Probe dialing addr (host:port), which is ready when
+// a TCP connection can be established.
+func TCPProbe(addr string) Probe {
+	return NewProbe("tcp "+addr, func(ctx context.Context) error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	})
+}
+
+// probeState is the state of the probes polled by EventuallyHealthy.
+type probeState struct {
+	mu      sync.Mutex
+	healthy []bool
+	errs    []error
+}
+
+// check checks the probes that are not healthy yet, and reports if all
+// of them are healthy. Each probe is given timeout to answer; a probe
+// still running when the polling stops is reported as timed out.
+func (s *probeState) check(ctx context.Context, probes []Probe, timeout time.Duration) bool {
+	all := true
+	for i, probe := range probes {
+		s.mu.Lock()
+		healthy := s.healthy[i]
+		if !healthy {
+			s.errs[i] = fmt.Errorf("no answer within %v: %w", timeout, context.DeadlineExceeded)
+		}
+		s.mu.Unlock()
+		if healthy {
+			continue
+		}
+		err := checkProbe(ctx, probe, timeout)
+		s.mu.Lock()
+		s.healthy[i] = err == nil
+		s.errs[i] = err
+		s.mu.Unlock()
+		if err != nil {
+			all = false
+		}
+	}
+	return all
+}
+
+// checkProbe runs probe.Check with a context canceled after timeout.
+func checkProbe(ctx context.Context, probe Probe, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return probe.Check(ctx)
+}
+
+// unhealthy lists the probes that are not healthy, with their last error.
+func (s *probeState) unhealthy(probes []Probe) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var lines []string
+	for i, probe := range probes {
+		if s.healthy[i] {
+			continue
+		}
+		reason := "not checked"
+		if s.errs[i] != nil {
+			reason = "last error: " + s.errs[i].Error()
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", probe, reason))
+	}
+	return lines
+}
+
+// EventuallyHealthy asserts that all the probes become ready within
+// waitFor, checking them every tick, which is the usual warm-up step of
+// integration tests using docker-compose or testcontainers:
+//
+//	require.EventuallyHealthy(t, []require.Probe{
+//		require.HTTPProbe("http://localhost:8080/healthz"),
+//		require.TCPProbe("localhost:5432"),
+//		grpcassert.HealthProbe(conn, ""),
+//	}, time.Minute, time.Second)
+//
+// A probe is not checked anymore once it is ready, and each check of a
+// probe is canceled after tick. On failure, the probes that are not ready
+// are reported with their last error. Like Eventually, waitFor is capped
+// at the test deadline.
+func EventuallyHealthy(t TestingT, probes []Probe, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyHealthy", probes, waitFor, tick, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	waitFor, capped := capToDeadline(t, span.capWait(waitFor))
+	ctx, cancel := context.WithTimeout(context.Background(), waitFor)
+	defer cancel()
+	state := &probeState{
+		healthy: make([]bool, len(probes)),
+		errs:    make([]error, len(probes)),
+	}
+	healthy := pollCondition(findClock(t), func() bool {
+		return state.check(ctx, probes, tick)
+	}, waitFor, tick)
+	if !span.end(a) || healthy {
+		return
+	}
+	reason := fmt.Sprintf("not healthy within %v", waitFor)
+	if capped {
+		reason = fmt.Sprintf("test deadline would be exceeded: not healthy in %v before the deadline", waitFor)
+	}
+	lines := state.unhealthy(probes)
+	a.Fail(fmt.Sprintf("%d of %d probes %s:\n\t%s", len(lines), len(probes), reason, strings.Join(lines, "\n\t")))
+}
@@ -0,0 +1,445 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andybalholm/brotli"
+)
+
+// maxDumpedBody is the maximum number of bytes of response body shown in
+// failure messages.
+const maxDumpedBody = 1024
+
+// httpDecompress is whether response bodies are decompressed by HTTP body
+// assertions, see SetHTTPDecompression.
+var httpDecompress = true
+
+// SetHTTPDecompression enables or disables the decompression of response
+// bodies by HTTP body assertions (enabled by default), according to the
+// Content-Encoding header of the response (gzip, deflate or br), so that
+// handlers behind compression middleware can be tested.
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetHTTPDecompression(enabled bool) {
+	httpDecompress = enabled
+}
+
+// decodeBody decompresses the response body according to Content-Encoding
+// header, applying the decodings in reverse order of the encodings.
+func decodeBody(header http.Header, body []byte) ([]byte, error) {
+	if !httpDecompress {
+		return body, nil
+	}
+	var encodings []string
+	for _, value := range header.Values("Content-Encoding") {
+		for _, encoding := range strings.Split(value, ",") {
+			encoding = strings.ToLower(strings.TrimSpace(encoding))
+			if encoding != "" && encoding != "identity" {
+				encodings = append(encodings, encoding)
+			}
+		}
+	}
+	for i := len(encodings) - 1; i >= 0; i-- {
+		var reader io.Reader
+		switch encodings[i] {
+		case "gzip", "x-gzip":
+			gz, err := gzip.NewReader(bytes.NewReader(body))
+			if err != nil {
+				return nil, fmt.Errorf("decoding gzip body: %w", err)
+			}
+			reader = gz
+		case "deflate":
+			// deflate is meant to be zlib format, but some servers send
+			// raw deflate data
+			zr, err := zlib.NewReader(bytes.NewReader(body))
+			if err != nil {
+				reader = flate.NewReader(bytes.NewReader(body))
+			} else {
+				reader = zr
+			}
+		case "br":
+			reader = brotli.NewReader(bytes.NewReader(body))
+		default:
+			return nil, fmt.Errorf("unsupported Content-Encoding %q", encodings[i])
+		}
+		decoded, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s body: %w", encodings[i], err)
+		}
+		body = decoded
+	}
+	return body, nil
+}
+
+// responseRecorder is an http.ResponseWriter recording the response of a
+// handler. It replaces httptest.ResponseRecorder so that programs using
+// the check package do not link net/http/httptest.
+type responseRecorder struct {
+	Code        int
+	Body        bytes.Buffer
+	header      http.Header
+	wroteHeader bool
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{Code: http.StatusOK, header: http.Header{}}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.Code = code
+}
+
+// Write records b, detecting the Content-Type from the first write if the
+// handler did not set it, like http.ResponseWriter of the server.
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		if _, ok := r.header["Content-Type"]; !ok && r.header.Get("Transfer-Encoding") == "" {
+			r.header.Set("Content-Type", http.DetectContentType(b))
+		}
+		r.WriteHeader(http.StatusOK)
+	}
+	return r.Body.Write(b)
+}
+
+// httpRecord serves a request with handler, and returns the recorded
+// response. values are added to the query of the URL.
+func httpRecord(handler http.Handler, method string, rawURL string, values url.Values) (*responseRecorder, error) {
+	req, err := http.NewRequest(method, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 {
+		query := req.URL.Query()
+		for key, list := range values {
+			for _, value := range list {
+				query.Add(key, value)
+			}
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+	w := newResponseRecorder()
+	handler.ServeHTTP(w, req)
+	return w, nil
+}
+
+// httpCode serves a request with handler, within the time budget of the
+// test, and returns the status code of the response. It fails and returns
+// false if the request could not be made, or the budget is spent.
+func httpCode(a *asserter, handler http.Handler, method string, rawURL string, values url.Values) (int, bool) {
+	a.t.Helper()
+	span, ok := startBudget(a)
+	if !ok {
+		return 0, false
+	}
+	w, err := httpRecord(handler, method, rawURL, values)
+	if !span.end(a) {
+		return 0, false
+	}
+	if err != nil {
+		a.Fail(fmt.Sprintf("Failed to build test request, got error: %v", err))
+		return 0, false
+	}
+	return w.Code, true
+}
+
+// httpBody serves a request with handler, and returns the decompressed
+// response body. values are added to the query of the URL.
+func httpBody(handler http.Handler, method string, rawURL string, values url.Values) (string, error) {
+	w, err := httpRecord(handler, method, rawURL, values)
+	if err != nil {
+		return "", err
+	}
+	body, err := decodeBody(w.Header(), w.Body.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// ContentType asserts that the Content-Type header value has the given
+// media type (ignoring case), and the given parameters, if any (the value
+// of charset is also compared ignoring case). Other parameters, such as
+// charset and boundary when not given, are ignored.
+//
+//	require.ContentType(t, resp.Header.Get("Content-Type"), "text/html", map[string]string{"charset": "utf-8"})
+func ContentType(t TestingT, contentType string, mediaType string, params map[string]string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContentType", contentType, mediaType, params, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	actualType, actualParams, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Invalid Content-Type %q: %v", contentType, err))
+		return
+	}
+	if !strings.EqualFold(actualType, mediaType) {
+		a.Fail(fmt.Sprintf("Content-Type mismatch:\nexpected: %s\nactual  : %s", mediaType, contentType))
+		return
+	}
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		expected := params[key]
+		actual, ok := actualParams[strings.ToLower(key)]
+		switch {
+		case !ok:
+			a.Fail(fmt.Sprintf("Content-Type %q has no parameter %q, expected %q", contentType, key, expected))
+			return
+		case actual != expected && !(strings.EqualFold(key, "charset") && strings.EqualFold(actual, expected)):
+			a.Fail(fmt.Sprintf("Content-Type %q parameter %q mismatch:\nexpected: %q\nactual  : %q", contentType, key, expected, actual))
+			return
+		}
+	}
+}
+
+// HTTPContentType asserts that the response of handler to the request has
+// the given media type in its Content-Type header, see ContentType.
+func HTTPContentType(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, mediaType string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPContentType", handler, method, url, values, mediaType, msgAndArgs)()
+	}
+	HTTPContentTypeWith(t, handler, method, url, values, mediaType, nil, msgAndArgs...)
+}
+
+// HTTPContentTypeWith is like HTTPContentType, and also asserts that the
+// Content-Type header has the given parameters, see ContentType.
+//
+//	require.HTTPContentTypeWith(t, handler, "GET", "/", nil, "text/html", map[string]string{"charset": "utf-8"})
+func HTTPContentTypeWith(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, mediaType string, params map[string]string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPContentTypeWith", handler, method, url, values, mediaType, params, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	w, err := httpRecord(handler, method, url, values)
+	if !span.end(a) {
+		return
+	}
+	if err != nil {
+		a.Fail(fmt.Sprintf("Failed to build request %s %s: %v", method, url, err))
+		return
+	}
+	ContentType(t, w.Header().Get("Content-Type"), mediaType, params, msgAndArgs...)
+}
+
+// ResponseContentType asserts that the response has the given media type
+// in its Content-Type header, see ContentType.
+func ResponseContentType(t TestingT, resp *http.Response, mediaType string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ResponseContentType", resp, mediaType, msgAndArgs)()
+	}
+	ContentType(t, resp.Header.Get("Content-Type"), mediaType, nil, msgAndArgs...)
+}
+
+// ResponseContentTypeWith is like ResponseContentType, and also asserts
+// that the Content-Type header has the given parameters, see ContentType.
+func ResponseContentTypeWith(t TestingT, resp *http.Response, mediaType string, params map[string]string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ResponseContentTypeWith", resp, mediaType, params, msgAndArgs)()
+	}
+	ContentType(t, resp.Header.Get("Content-Type"), mediaType, params, msgAndArgs...)
+}
+
+// HTTPPollOptions configures the requests of EventuallyHTTPSuccessWith.
+type HTTPPollOptions struct {
+	// Client is used for sending requests, http.DefaultClient if nil.
+	Client *http.Client
+	// Method is the request method, GET if empty.
+	Method string
+	// Statuses are the response status codes considered as success.
+	// If empty, any 2xx status is a success.
+	Statuses []int
+}
+
+// isSuccess reports if the status code is a success according to opts.
+func (opts *HTTPPollOptions) isSuccess(code int) bool {
+	if len(opts.Statuses) == 0 {
+		return code >= 200 && code < 300
+	}
+	for _, status := range opts.Statuses {
+		if status == code {
+			return true
+		}
+	}
+	return false
+}
+
+// EventuallyHTTPSuccess asserts that a GET request to url gets a 2xx
+// response within waitFor, sending a request each tick. It is meant for
+// waiting for services started by integration tests to come up.
+// See EventuallyHTTPSuccessWith for other clients, methods and statuses.
+func EventuallyHTTPSuccess(t TestingT, url string, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyHTTPSuccess", url, waitFor, tick, msgAndArgs)()
+	}
+	EventuallyHTTPSuccessWith(t, url, HTTPPollOptions{}, waitFor, tick, msgAndArgs...)
+}
+
+// EventuallyHTTPSuccessWith is like EventuallyHTTPSuccess, with the client,
+// method and success statuses given by opts. On failure, the last response
+// (status and beginning of body) or error is shown.
+// Like Eventually, waitFor is capped at the test deadline.
+func EventuallyHTTPSuccessWith(t TestingT, url string, opts HTTPPollOptions, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyHTTPSuccessWith", url, opts, waitFor, tick, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	method := opts.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	waitFor, capped := capToDeadline(t, span.capWait(waitFor))
+	ctx, cancel := context.WithTimeout(context.Background(), waitFor)
+	defer cancel()
+
+	attempts := 0
+	var last string
+	for {
+		attempts++
+		ok, result := httpAttempt(ctx, client, method, url, &opts)
+		if ok {
+			span.end(a)
+			return
+		}
+		last = result
+		select {
+		case <-ctx.Done():
+		case <-time.After(tick):
+			continue
+		}
+		break
+	}
+	if !span.end(a) {
+		return
+	}
+	reason := fmt.Sprintf("%s %s did not succeed within %v", method, url, waitFor)
+	if capped {
+		reason = fmt.Sprintf("test deadline would be exceeded: %s %s did not succeed in %v before the deadline", method, url, waitFor)
+	}
+	a.Fail(fmt.Sprintf("%s (%d attempts), last %s", reason, attempts, last))
+}
+
+// httpAttempt sends a request, and reports if it succeeded, along with a
+// description of the response or error.
+func httpAttempt(ctx context.Context, client *http.Client, method string, url string, opts *HTTPPollOptions) (bool, string) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return false, fmt.Sprintf("error: %v", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Sprintf("error: %v", err)
+	}
+	defer resp.Body.Close()
+	if opts.isSuccess(resp.StatusCode) {
+		return true, ""
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxDumpedBody+1))
+	truncated := ""
+	if len(body) > maxDumpedBody {
+		body = body[:maxDumpedBody]
+		truncated = "..."
+	}
+	if len(body) == 0 {
+		return false, fmt.Sprintf("response: %s", resp.Status)
+	}
+	return false, fmt.Sprintf("response: %s\n%s%s", resp.Status, body, truncated)
+}
+
+// HTTPBodyMatchesGolden asserts that the (decompressed, see
+// SetHTTPDecompression) body of the response of handler to req matches the
+// content of the golden file at goldenPath. With
+// -demand.update flag of go test (or opts.Update), the golden file is
+// written instead.
+//
+// Unless opts.Raw is set, JSON bodies (by Content-Type of the response)
+// are pretty-printed with sorted keys, both for writing and comparing, so
+// that the golden files are readable and the differences are shown by line.
+func HTTPBodyMatchesGolden(t TestingT, handler http.Handler, req *http.Request, goldenPath string, opts GoldenOptions, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPBodyMatchesGolden", handler, req, goldenPath, opts, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	w := newResponseRecorder()
+	handler.ServeHTTP(w, req)
+	body, err := decodeBody(w.Header(), w.Body.Bytes())
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	var normalize func([]byte) []byte
+	if isJSONContentType(w.Header().Get("Content-Type")) {
+		normalize = normalizeJSON
+	}
+	matchGolden(a, goldenPath, body, opts, normalize)
+	span.end(a)
+}
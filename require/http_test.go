@@ -0,0 +1,68 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestContentTypeWith(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+	}
+	tests := []struct {
+		mediaType string
+		params    map[string]string
+		failed    bool
+	}{
+		{"text/html", nil, false},
+		{"text/html", map[string]string{"charset": "utf-8"}, false},
+		{"text/html", map[string]string{"charset": "latin1"}, true},
+		{"text/html", map[string]string{"boundary": "x"}, true},
+		{"text/plain", nil, true},
+	}
+	for _, tt := range tests {
+		ft := newFakeT(t)
+		HTTPContentTypeWith(ft, handler, http.MethodGet, "/", nil, tt.mediaType, tt.params)
+		if ft.Failed() != tt.failed {
+			t.Errorf("HTTPContentTypeWith(%q, %v) failed = %v, expected %v", tt.mediaType, tt.params, ft.Failed(), tt.failed)
+		}
+
+		ft = newFakeT(t)
+		resp := &http.Response{Header: http.Header{"Content-Type": {"text/html; charset=UTF-8"}}}
+		ResponseContentTypeWith(ft, resp, tt.mediaType, tt.params)
+		if ft.Failed() != tt.failed {
+			t.Errorf("ResponseContentTypeWith(%q, %v) failed = %v, expected %v", tt.mediaType, tt.params, ft.Failed(), tt.failed)
+		}
+	}
+}
+
+func TestResponseRecorder(t *testing.T) {
+	ft := newFakeT(t)
+	HTTPContentType(ft, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<!DOCTYPE html><html></html>"))
+	}, http.MethodGet, "/", nil, "text/html")
+	expectFailed(t, ft, false)
+}
@@ -0,0 +1,198 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Call is an assertion call, as seen by interceptors, see Intercept.
+type Call struct {
+	// Name is the name of the assertion, such as "Equal" or "NoErrorf".
+	Name string
+	// Args are the arguments of the assertion after t, with the variadic
+	// msgAndArgs (or args of f-variants) as a single []any.
+	Args []any
+	// T is the TestingT of the assertion, which interceptors can use to
+	// fail the test.
+	T TestingT
+
+	// Duration is the time spent in the assertion, set for After.
+	Duration time.Duration
+	// Failed reports if the assertion failed, set for After.
+	Failed bool
+}
+
+// Interceptor is a pair of hooks called around assertions, see Intercept.
+// Either of them can be nil.
+type Interceptor struct {
+	// Before is called before the assertion.
+	Before func(call *Call)
+	// After is called after the assertion, even if it stopped the test.
+	After func(call *Call)
+}
+
+// interceptT is a TestingT with interceptors, counting the failures to
+// report them to After, see Intercept.
+type interceptT struct {
+	TestingT
+	interceptors []Interceptor
+	failures     atomic.Int64
+}
+
+func (it *interceptT) Error(args ...any) {
+	it.TestingT.Helper()
+	it.failures.Add(1)
+	it.TestingT.Error(args...)
+}
+
+func (it *interceptT) Errorf(format string, args ...any) {
+	it.TestingT.Helper()
+	it.failures.Add(1)
+	it.TestingT.Errorf(format, args...)
+}
+
+func (it *interceptT) Fatal(args ...any) {
+	it.TestingT.Helper()
+	it.failures.Add(1)
+	it.TestingT.Fatal(args...)
+}
+
+func (it *interceptT) Fatalf(format string, args ...any) {
+	it.TestingT.Helper()
+	it.failures.Add(1)
+	it.TestingT.Fatalf(format, args...)
+}
+
+func (it *interceptT) Fail() {
+	it.failures.Add(1)
+	it.TestingT.Fail()
+}
+
+func (it *interceptT) FailNow() {
+	it.failures.Add(1)
+	it.TestingT.FailNow()
+}
+
+// intercept calls the Before hooks for an assertion, and returns a function
+// calling the After hooks in reverse order, to be deferred. It is called by
+// the assertions and the methods of Assertions, and does nothing if the
+// calling assertion is not called by user code, see userCall.
+func (it *interceptT) intercept(t TestingT, name string, args ...any) func() {
+	if !userCall() {
+		return func() {}
+	}
+	call := &Call{Name: name, Args: args, T: t}
+	for _, interceptor := range it.interceptors {
+		if interceptor.Before != nil {
+			interceptor.Before(call)
+		}
+	}
+	failures := it.failures.Load()
+	start := time.Now()
+	return func() {
+		call.Duration = time.Since(start)
+		call.Failed = it.failures.Load() != failures
+		for i := len(it.interceptors) - 1; i >= 0; i-- {
+			if after := it.interceptors[i].After; after != nil {
+				after(call)
+			}
+		}
+	}
+}
+
+// userCall reports if the assertion calling intercept is called by user
+// code, so that an assertion is intercepted once: not when it is called by
+// another function of the library (such as a method of Assertions or the
+// f-variant of the assertion, which intercept the call themselves), nor
+// when it is called by an interceptor, directly or not.
+func userCall() bool {
+	pc := make([]uintptr, 64)
+	// skip runtime.Callers, userCall, intercept and the assertion
+	count := runtime.Callers(4, pc)
+	frames := runtime.CallersFrames(pc[:count])
+	for first := true; ; first = false {
+		frame, more := frames.Next()
+		if first && isLibraryFrame(frame) {
+			return false
+		}
+		if strings.HasPrefix(frame.Function, libraryPath+"require.(*interceptT).intercept") {
+			return false
+		}
+		if !more {
+			return true
+		}
+	}
+}
+
+// Intercept returns Assertions whose assertion methods call interceptors
+// before and after each assertion, with its name and arguments, for
+// cross-cutting concerns of a shared test harness, such as tracing, timing
+// slow assertions or enforcing conventions:
+//
+//	r := require.Intercept(t, require.Interceptor{
+//		After: func(call *require.Call) {
+//			if call.Duration > time.Second {
+//				t.Logf("slow assertion %s: %v", call.Name, call.Duration)
+//			}
+//		},
+//	})
+//
+// Before hooks are called in order, and After hooks in reverse order, so
+// the first interceptor wraps the others. They are kept
+// by the Assertions derived from the returned ones, such as by Soft or
+// With, and by the TestingT they give to callbacks, such as the t of
+// Flaky, whose package-level assertions are intercepted as well:
+//
+//	r.Flaky(3, func(t require.TestingT) {
+//		require.Equal(t, http.StatusOK, status()) // intercepted
+//	})
+//
+// Assertions called by interceptors are not intercepted. Failures during
+// concurrent assertions may be reported to After of any of them.
+func Intercept(t TestingT, interceptors ...Interceptor) *Assertions {
+	if inner := findIntercept(t); inner != nil {
+		interceptors = append(append([]Interceptor{}, inner.interceptors...), interceptors...)
+	}
+	return &Assertions{t: &interceptT{TestingT: t, interceptors: interceptors}}
+}
+
+// Intercept returns Assertions with interceptors added after the existing
+// ones, see Intercept.
+func (a *Assertions) Intercept(interceptors ...Interceptor) *Assertions {
+	return Intercept(a.t, interceptors...)
+}
+
+// findIntercept returns the outermost interceptT that t is or wraps, or nil.
+func findIntercept(t TestingT) *interceptT {
+	for ; t != nil; t = innerT(t) {
+		if it, ok := t.(*interceptT); ok {
+			return it
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntercept(t *testing.T) {
+	ft := newFakeT(t)
+	var calls []string
+	r := Intercept(ft, Interceptor{
+		Before: func(call *Call) {
+			// assertions of interceptors are not intercepted
+			NotNil(call.T, call)
+		},
+		After: func(call *Call) {
+			if call.Failed {
+				calls = append(calls, call.Name+" failed")
+				return
+			}
+			calls = append(calls, call.Name)
+		},
+	})
+
+	r.Equal(1, 1)
+	r.Equalf(1, 1, "msg %d", 1)
+	Equal(r.t, 1, 2)
+	Equalf(r.t, 1, 1, "msg")
+	r.Flaky(1, func(t TestingT) {
+		True(t, true)
+	})
+
+	expected := []string{"Equal", "Equalf", "Equal failed", "Equalf", "True", "Flaky"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("intercepted calls %q, expected %q", calls, expected)
+	}
+}
@@ -25,11 +25,24 @@ package require
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ilius/demand/internal/core"
 )
 
 // isEmpty gets whether the specified object is considered empty or not.
 func isEmpty(object interface{}) bool {
+	if empty, ok := isEmptyFast(object); ok {
+		return empty
+	}
 
 	// get nil case out of the way
 	if object == nil {
@@ -37,6 +50,24 @@ func isEmpty(object interface{}) bool {
 	}
 
 	objValue := reflect.ValueOf(object)
+	if objValue.Kind() == reflect.Ptr && objValue.IsNil() {
+		return true
+	}
+
+	if isIterator(object) {
+		elements, _ := drainIterator(object, 1)
+		return len(elements) == 0
+	}
+
+	// types such as bytes.Buffer define their own length
+	if l, ok := object.(lener); ok {
+		return l.Len() == 0
+	}
+
+	// types such as time.Time define their own zero value
+	if z, ok := object.(interface{ IsZero() bool }); ok {
+		return z.IsZero()
+	}
 
 	switch objValue.Kind() {
 	// collection types are empty when they have no element
@@ -44,9 +75,6 @@ func isEmpty(object interface{}) bool {
 		return objValue.Len() == 0
 	// pointers are empty if nil or if the value they point to is empty
 	case reflect.Ptr:
-		if objValue.IsNil() {
-			return true
-		}
 		deref := objValue.Elem().Interface()
 		return isEmpty(deref)
 	// for all other types, compare against the zero value
@@ -57,13 +85,112 @@ func isEmpty(object interface{}) bool {
 	}
 }
 
-// isList checks that the provided value is array or slice.
-func isList(list interface{}) bool {
+// lener is implemented by types with a length, such as bytes.Buffer,
+// strings.Builder and custom containers, used by Len and Empty.
+type lener interface {
+	Len() int
+}
+
+// describeValue formats a value for the failure messages of Empty and
+// NotEmpty, with the number of elements of collections.
+func describeValue(object any) string {
+	if object == nil {
+		return "<nil>"
+	}
+	value := reflect.ValueOf(object)
+	if l, ok := object.(lener); ok && !(value.Kind() == reflect.Ptr && value.IsNil()) {
+		return fmt.Sprintf("%v (%T with length %d)", object, object, l.Len())
+	}
+	switch value.Kind() {
+	case reflect.Chan, reflect.Map, reflect.Slice, reflect.Array:
+		return fmt.Sprintf("%v (%T with %d elements)", object, object, value.Len())
+	case reflect.String:
+		return fmt.Sprintf("%q", object)
+	}
+	return fmt.Sprintf("%v (%T)", object, object)
+}
+
+// isCollection checks that the provided value is array, slice, map,
+// iterator or Container.
+func isCollection(list interface{}) bool {
+	if _, ok := list.(Container); ok {
+		return true
+	}
 	switch reflect.TypeOf(list).Kind() {
-	case reflect.Array, reflect.Slice:
+	case reflect.Array, reflect.Slice, reflect.Map:
 		return true
 	}
-	return false
+	return isIterator(list)
+}
+
+// isMapLike reports if the collection type is a map or an iter.Seq2,
+// whose elements are key/value pairs.
+func isMapLike(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Map || iteratorArity(typ) == 2
+}
+
+// collectionElemType returns the type of the elements (or values) of a
+// collection type.
+func collectionElemType(typ reflect.Type) reflect.Type {
+	if typ.Implements(containerType) {
+		return anyType
+	}
+	if typ.Kind() == reflect.Func {
+		return iteratorElemType(typ)
+	}
+	return typ.Elem()
+}
+
+// mapEntry is a key/value pair of a map, compared by ElementsMatch.
+type mapEntry struct {
+	Key   any
+	Value any
+}
+
+// String formats the entry like fmt formats the entries of maps.
+func (e mapEntry) String() string {
+	return fmt.Sprintf("%v:%v", e.Key, e.Value)
+}
+
+// collectionElements returns the elements of an array, slice, map or
+// iterator. For a map or iter.Seq2, the elements are its values, or its
+// key/value pairs (as mapEntry) if entries is true.
+func collectionElements(list any, entries bool) ([]any, error) {
+	if c, ok := list.(Container); ok {
+		return containerElements(c), nil
+	}
+	value := reflect.ValueOf(list)
+	switch value.Kind() {
+	case reflect.Map:
+		elements := make([]any, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			if entries {
+				elements = append(elements, mapEntry{Key: iter.Key().Interface(), Value: iter.Value().Interface()})
+			} else {
+				elements = append(elements, iter.Value().Interface())
+			}
+		}
+		return elements, nil
+	case reflect.Func:
+		elements, err := drainIterator(list, maxIteratorElements)
+		if err != nil {
+			return nil, err
+		}
+		if !entries {
+			for i, element := range elements {
+				if entry, ok := element.(mapEntry); ok {
+					elements[i] = entry.Value
+				}
+			}
+		}
+		return elements, nil
+	}
+	elements := make([]any, value.Len())
+	for i := range elements {
+		elements[i] = value.Index(i).Interface()
+	}
+	return elements, nil
 }
 
 // objectsAreEqual determines if two objects are considered equal.
@@ -73,6 +200,9 @@ func objectsAreEqual(expected, actual interface{}) bool {
 	if expected == nil || actual == nil {
 		return expected == actual
 	}
+	if equal, ok := equalFast(actual, expected); ok {
+		return equal
+	}
 
 	exp, ok := expected.([]byte)
 	if !ok {
@@ -132,26 +262,19 @@ func objectsAreEqualValues(expected, actual interface{}) bool {
 	return expectedValue.Convert(actualType).Interface() == actual
 }
 
-// diffLists diffs two arrays/slices and returns slices of elements that are only in A and only in B.
+// diffLists diffs two lists of elements and returns slices of elements that are only in A and only in B.
 // If some element is present multiple times, each instance is counted separately (e.g. if something is 2x in A and
 // 5x in B, it will be 0x in extraA and 3x in extraB). The order of items in both lists is ignored.
-func diffLists(listA, listB interface{}) (extraA, extraB []interface{}) {
-	aValue := reflect.ValueOf(listA)
-	bValue := reflect.ValueOf(listB)
-
-	aLen := aValue.Len()
-	bLen := bValue.Len()
-
-	// Mark indexes in bValue that we already used
-	visited := make([]bool, bLen)
-	for i := 0; i < aLen; i++ {
-		element := aValue.Index(i).Interface()
+func diffLists(listA, listB []any) (extraA, extraB []any) {
+	// Mark indexes in listB that we already used
+	visited := make([]bool, len(listB))
+	for _, element := range listA {
 		found := false
-		for j := 0; j < bLen; j++ {
+		for j, other := range listB {
 			if visited[j] {
 				continue
 			}
-			if objectsAreEqual(bValue.Index(j).Interface(), element) {
+			if objectsAreEqual(other, element) {
 				visited[j] = true
 				found = true
 				break
@@ -162,11 +285,10 @@ func diffLists(listA, listB interface{}) (extraA, extraB []interface{}) {
 		}
 	}
 
-	for j := 0; j < bLen; j++ {
-		if visited[j] {
-			continue
+	for j, other := range listB {
+		if !visited[j] {
+			extraB = append(extraB, other)
 		}
-		extraB = append(extraB, bValue.Index(j).Interface())
 	}
 
 	return
@@ -174,84 +296,430 @@ func diffLists(listA, listB interface{}) (extraA, extraB []interface{}) {
 
 // isNil checks if a specified object is nil or not, without Failing.
 func isNil(object interface{}) bool {
-	if object == nil {
-		return true
+	if isNil, ok := isNilFast(object); ok {
+		return isNil
 	}
+	return core.IsNil(object)
+}
 
-	value := reflect.ValueOf(object)
-	switch value.Kind() {
-	case
-		reflect.Chan, reflect.Func,
-		reflect.Interface, reflect.Map,
-		reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+// deadlineMargin is the time reserved before the test deadline for
+// reporting the failure of a polling assertion.
+const deadlineMargin = time.Second
 
-		return value.IsNil()
+// capToDeadline caps waitFor to the time remaining until the test deadline
+// (minus deadlineMargin), if t has a deadline.
+// The second return value is true if waitFor was capped.
+func capToDeadline(t TestingT, waitFor time.Duration) (time.Duration, bool) {
+	deadline, ok := TestDeadline(t)
+	if !ok {
+		return waitFor, false
+	}
+	remaining := time.Until(deadline) - deadlineMargin
+	if remaining >= waitFor {
+		return waitFor, false
+	}
+	if remaining < 0 {
+		remaining = 0
 	}
+	return remaining, true
+}
 
-	return false
+// pollCondition calls condition every tick of clock (in a separate
+// goroutine, so that a slow condition does not delay the timeout) until it
+// returns true, or waitFor has passed.
+// It returns true if condition returned true.
+//
+// In a testing/synctest bubble, a call of condition running at the timeout
+// is waited for, since goroutines must not outlive the bubble, and waiting
+// only advances the fake clock.
+func pollCondition(clock Clock, condition func() bool, waitFor time.Duration, tick time.Duration) bool {
+	ch := make(chan bool, 1)
+
+	timeout := clock.After(waitFor)
+
+	ticker := clock.NewTicker(tick)
+	defer ticker.Stop()
+
+	for tickC := ticker.Chan(); ; {
+		select {
+		case <-timeout:
+			if tickC == nil && inSynctestBubble() {
+				<-ch
+			}
+			return false
+		case <-tickC:
+			tickC = nil
+			go func() { ch <- condition() }()
+		case v := <-ch:
+			if v {
+				return true
+			}
+			tickC = ticker.Chan()
+		}
+	}
 }
 
-// copyExportedFields iterates downward through nested data structures and creates a copy
-// that only contains the exported struct fields.
-func copyExportedFields(expected interface{}) interface{} {
-	if isNil(expected) {
-		return expected
+// messageFromMsgAndArgs formats msgAndArgs (format and arguments) as a
+// message appended to the given message.
+func messageFromMsgAndArgs(msg string, msgAndArgs []any) string {
+	if len(msgAndArgs) == 0 {
+		return msg
 	}
+	return msg + " - " + core.FormatMsgAndArgs(msgAndArgs)
+}
 
-	expectedType := reflect.TypeOf(expected)
-	expectedKind := expectedType.Kind()
+// isEqualConverted determines if two objects are considered equal, calling
+// the Equal method of actual if it implements Equaler, and converting
+// expected to the type of actual if they are of different but convertible
+// types.
+func isEqualConverted(actual, expected any) bool {
+	if equal, ok := equalFast(actual, expected); ok {
+		return equal
+	}
+	if isNil(actual) || isNil(expected) {
+		if isNil(actual) != isNil(expected) {
+			return false
+		}
+		return actual == expected
+	}
+
+	if e, ok := actual.(Equaler); ok {
+		return e.Equal(expected)
+	}
+
+	if reflect.DeepEqual(actual, expected) {
+		return true
+	}
+
+	actualValue := reflect.ValueOf(actual)
 	expectedValue := reflect.ValueOf(expected)
+	if expectedValue.Type().ConvertibleTo(actualValue.Type()) {
+		return reflect.DeepEqual(actual, expectedValue.Convert(actualValue.Type()).Interface())
+	}
 
-	switch expectedKind {
-	case reflect.Struct:
-		result := reflect.New(expectedType).Elem()
-		for i := 0; i < expectedType.NumField(); i++ {
-			field := expectedType.Field(i)
-			isExported := field.IsExported()
-			if isExported {
-				fieldValue := expectedValue.Field(i)
-				if isNil(fieldValue) || isNil(fieldValue.Interface()) {
-					continue
-				}
-				newValue := copyExportedFields(fieldValue.Interface())
-				result.Field(i).Set(reflect.ValueOf(newValue))
-			}
+	return false
+}
+
+// equalT compares values of a comparable type with ==, unless the type is
+// an interface or implements Equaler. Values that == panics on, such as
+// structs with interface fields holding slices, are compared deeply.
+func equalT[T comparable](expected, actual T) (equal bool) {
+	if reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Interface {
+		return isEqualConverted(actual, expected)
+	}
+	if _, ok := any(actual).(Equaler); ok {
+		return isEqualConverted(actual, expected)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			equal = isEqualConverted(actual, expected)
 		}
-		return result.Interface()
+	}()
+	return expected == actual
+}
 
-	case reflect.Ptr:
-		result := reflect.New(expectedType.Elem())
-		unexportedRemoved := copyExportedFields(expectedValue.Elem().Interface())
-		result.Elem().Set(reflect.ValueOf(unexportedRemoved))
-		return result.Interface()
+// buildErrorChainString returns the messages of the errors in err's chain
+// (following Unwrap() error), one per line.
+func buildErrorChainString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	e := errors.Unwrap(err)
+	chain := fmt.Sprintf("%q", err.Error())
+	for e != nil {
+		chain += fmt.Sprintf("\n\t%q", e.Error())
+		e = errors.Unwrap(e)
+	}
+	return chain
+}
+
+// toFloat converts a numeric value to float64.
+func toFloat(x any) (float64, bool) {
+	value := reflect.ValueOf(x)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
+// boolType is the result type of Contains methods used by containsElement.
+var boolType = reflect.TypeOf(true)
 
+// containsMethod calls the Contains method of s with element, if s has a
+// Contains method taking a single parameter that element is assignable or
+// convertible to, and returning bool.
+func containsMethod(s any, element any) (ok, found bool) {
+	method := reflect.ValueOf(s).MethodByName("Contains")
+	if !method.IsValid() {
+		return false, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 1 || methodType.IsVariadic() || methodType.NumOut() != 1 || methodType.Out(0) != boolType {
+		return false, false
+	}
+	paramType := methodType.In(0)
+	var arg reflect.Value
+	if element == nil {
+		switch paramType.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+			arg = reflect.Zero(paramType)
+		default:
+			return false, false
+		}
+	} else {
+		arg = reflect.ValueOf(element)
+		switch {
+		case arg.Type().AssignableTo(paramType):
+		case arg.Type().ConvertibleTo(paramType) && arg.Kind() != reflect.String && paramType.Kind() != reflect.String:
+			arg = arg.Convert(paramType)
+		default:
+			return false, false
+		}
+	}
+	return true, method.Call([]reflect.Value{arg})[0].Bool()
+}
+
+// toBytes returns the content of a string or byte slice.
+func toBytes(value reflect.Value) ([]byte, bool) {
+	switch {
+	case value.Kind() == reflect.String:
+		return []byte(value.String()), true
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return value.Bytes(), true
+	}
+	return nil, false
+}
+
+// containsElement checks if:
+//   - s has a Contains method accepting element, and it returns true
+//   - the string or byte slice s contains the string or byte slice element
+//     as a substring, or the rune element (for strings) or byte element
+//     (for byte slices)
+//   - the array, slice or iter.Seq s contains an element equal to element
+//   - the map or iter.Seq2 s contains the key element
+//   - the Container s contains an element equal to element
+//
+// ok is false if s and element are not of supported types.
+func containsElement(s any, element any) (ok, found bool) {
+	if found, ok := containsFast(s, element); ok {
+		return true, found
+	}
+	sType := reflect.TypeOf(s)
+	if sType == nil {
+		return false, false
+	}
+	if ok, found := containsMethod(s, element); ok {
+		return true, found
+	}
+	if c, ok := s.(Container); ok {
+		return true, containerContains(c, element)
+	}
+	sValue := reflect.ValueOf(s)
+	elemValue := reflect.ValueOf(element)
+	if sBytes, isBytes := toBytes(sValue); isBytes && element != nil {
+		if elemBytes, ok := toBytes(elemValue); ok {
+			return true, bytes.Contains(sBytes, elemBytes)
+		}
+		if sValue.Kind() == reflect.String {
+			if elemValue.Kind() != reflect.Int32 {
+				return false, false
+			}
+			return true, strings.ContainsRune(sValue.String(), rune(elemValue.Int()))
+		}
+	}
+	switch sType.Kind() {
+	case reflect.Func:
+		if !isIterator(s) {
+			return false, false
+		}
+		// elements beyond the cap of drainIterator are not searched
+		elements, _ := drainIterator(s, maxIteratorElements)
+		for _, e := range elements {
+			if entry, ok := e.(mapEntry); ok {
+				// iter.Seq2 is searched by key, like maps
+				e = entry.Key
+			}
+			if isEqualConverted(e, element) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Map:
+		return true, mapContains(sValue, element, false)
 	case reflect.Array, reflect.Slice:
-		var result reflect.Value
-		if expectedKind == reflect.Array {
-			result = reflect.New(reflect.ArrayOf(expectedValue.Len(), expectedType.Elem())).Elem()
-		} else {
-			result = reflect.MakeSlice(expectedType, expectedValue.Len(), expectedValue.Len())
+		for i := 0; i < sValue.Len(); i++ {
+			if isEqualConverted(sValue.Index(i).Interface(), element) {
+				return true, true
+			}
 		}
-		for i := 0; i < expectedValue.Len(); i++ {
-			index := expectedValue.Index(i)
-			if isNil(index) {
-				continue
+		return true, false
+	}
+	return false, false
+}
+
+// subsetOf reports if list (an array, slice or map) contains all the
+// elements of subset (an array or slice), or all the entries of subset if
+// both are maps, see Subset.
+func subsetOf(list any, subset any) (bool, error) {
+	listKind := reflect.ValueOf(list).Kind()
+	if listKind != reflect.Array && listKind != reflect.Slice && listKind != reflect.Map {
+		return false, fmt.Errorf("%#v has an unsupported type %T, expecting array, slice or map", list, list)
+	}
+	subsetValue := reflect.ValueOf(subset)
+	subsetKind := subsetValue.Kind()
+	if subsetKind == reflect.Map && listKind == reflect.Map {
+		listValue := reflect.ValueOf(list)
+		if !subsetValue.Type().Key().AssignableTo(listValue.Type().Key()) {
+			return subsetValue.Len() == 0, nil
+		}
+		iter := subsetValue.MapRange()
+		for iter.Next() {
+			value := listValue.MapIndex(iter.Key())
+			if !value.IsValid() || !objectsAreEqual(iter.Value().Interface(), value.Interface()) {
+				return false, nil
 			}
-			unexportedRemoved := copyExportedFields(index.Interface())
-			result.Index(i).Set(reflect.ValueOf(unexportedRemoved))
 		}
-		return result.Interface()
+		return true, nil
+	}
+	if subsetKind != reflect.Array && subsetKind != reflect.Slice {
+		return false, fmt.Errorf("%#v has an unsupported type %T, expecting array or slice", subset, subset)
+	}
+	for i := 0; i < subsetValue.Len(); i++ {
+		if _, found := containsElement(list, subsetValue.Index(i).Interface()); !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
 
-	case reflect.Map:
-		result := reflect.MakeMap(expectedType)
-		for _, k := range expectedValue.MapKeys() {
-			index := expectedValue.MapIndex(k)
-			unexportedRemoved := copyExportedFields(index.Interface())
-			result.SetMapIndex(k, reflect.ValueOf(unexportedRemoved))
+// mapContains checks if the map m has the key element, or a value equal
+// to element if byValue is true.
+func mapContains(m reflect.Value, element any, byValue bool) bool {
+	iter := m.MapRange()
+	for iter.Next() {
+		e := iter.Key()
+		if byValue {
+			e = iter.Value()
 		}
-		return result.Interface()
+		if isEqualConverted(e.Interface(), element) {
+			return true
+		}
+	}
+	return false
+}
+
+// didPanic returns true if the function passed to it panics, along with
+// the recovered panic value and the stack trace.
+func didPanic(f func()) (didPanic bool, message any, stack string) {
+	didPanic = true
+
+	defer func() {
+		message = recover()
+		if didPanic {
+			stack = stackTrace(1)
+		}
+	}()
+
+	// call the target function
+	f()
+	didPanic = false
+
+	return
+}
+
+// matchRegexp returns true if a specified regexp matches a string.
+func matchRegexp(rx any, str any) (bool, error) {
+	var r *regexp.Regexp
+	switch rxValue := rx.(type) {
+	case *regexp.Regexp:
+		r = rxValue
+	case string:
+		var err error
+		r, err = regexp.Compile(rxValue)
+		if err != nil {
+			return false, fmt.Errorf("invalid regexp %q: %w", rxValue, err)
+		}
+	default:
+		return false, fmt.Errorf("expected regexp to be *regexp.Regexp or string, but got %T", rx)
+	}
 
+	switch v := str.(type) {
+	case []byte:
+		return r.Match(v), nil
+	case string:
+		return r.MatchString(v), nil
 	default:
-		return expected
+		return r.MatchString(fmt.Sprint(v)), nil
+	}
+}
+
+// samePointers checks if two generic interface objects are pointers of the
+// same type pointing to the same object.
+func samePointers(first, second any) bool {
+	firstPtr, secondPtr := reflect.ValueOf(first), reflect.ValueOf(second)
+	if firstPtr.Kind() != reflect.Ptr || secondPtr.Kind() != reflect.Ptr {
+		return false
+	}
+
+	firstType, secondType := reflect.TypeOf(first), reflect.TypeOf(second)
+	if firstType != secondType {
+		return false
+	}
+
+	// compare pointer addresses
+	return first == second
+}
+
+// isZero checks if i is nil or the zero value of its type.
+func isZero(i any) bool {
+	return i == nil || reflect.DeepEqual(i, reflect.Zero(reflect.TypeOf(i)).Interface())
+}
+
+// packagePath is the import path of this package, used for skipping its
+// frames in call stacks.
+const packagePath = "github.com/ilius/demand/require"
+
+// callContext returns the location and source line of the first call in
+// the stack outside of this package, such as the call of an assertion in
+// a test.
+func callContext() string {
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	for {
+		frame, more := frames.Next()
+		if !isPackageFrame(frame) {
+			return sourceLocation(frame.File, frame.Line)
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// isPackageFrame reports if frame is a function of this package (excluding
+// tests).
+func isPackageFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, packagePath+".") && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// sourceLocation returns the file name and line, along with the source
+// code of the line if the file is readable.
+func sourceLocation(file string, line int) string {
+	location := fmt.Sprintf("%s:%d", filepath.Base(file), line)
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return location
+	}
+	lines := strings.Split(string(data), "\n")
+	if line < 1 || line > len(lines) {
+		return location
 	}
+	return location + ": " + strings.TrimSpace(lines[line-1])
 }
@@ -0,0 +1,94 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// maxIteratorElements is the maximum number of elements drained from an
+// iterator by collection assertions, so that infinite iterators fail
+// instead of hanging.
+const maxIteratorElements = 1 << 20
+
+// iteratorArity returns 1 if typ is an iterator of single values like
+// iter.Seq[V], 2 if it is an iterator of pairs like iter.Seq2[K, V], and
+// 0 otherwise.
+func iteratorArity(typ reflect.Type) int {
+	if typ == nil || typ.Kind() != reflect.Func || typ.NumIn() != 1 || typ.NumOut() != 0 {
+		return 0
+	}
+	yield := typ.In(0)
+	if yield.Kind() != reflect.Func || yield.NumOut() != 1 || yield.Out(0).Kind() != reflect.Bool {
+		return 0
+	}
+	switch yield.NumIn() {
+	case 1, 2:
+		return yield.NumIn()
+	}
+	return 0
+}
+
+// iteratorElemType returns the type of the values of an iterator (the
+// type V of iter.Seq[V] and iter.Seq2[K, V]).
+func iteratorElemType(typ reflect.Type) reflect.Type {
+	yield := typ.In(0)
+	return yield.In(yield.NumIn() - 1)
+}
+
+// drainIterator returns up to limit elements of the iterator seq: the
+// values of an iter.Seq, or the pairs of an iter.Seq2 as mapEntry.
+// It returns an error if seq yields more than limit elements.
+func drainIterator(seq any, limit int) ([]any, error) {
+	value := reflect.ValueOf(seq)
+	if value.IsNil() {
+		return nil, nil
+	}
+	var elements []any
+	exceeded := false
+	yieldType := value.Type().In(0)
+	yield := reflect.MakeFunc(yieldType, func(args []reflect.Value) []reflect.Value {
+		if len(elements) >= limit {
+			exceeded = true
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		if len(args) == 2 {
+			elements = append(elements, mapEntry{Key: args[0].Interface(), Value: args[1].Interface()})
+		} else {
+			elements = append(elements, args[0].Interface())
+		}
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+	value.Call([]reflect.Value{yield})
+	if exceeded {
+		return elements, fmt.Errorf("iterator %T yielded more than %d elements", seq, limit)
+	}
+	return elements, nil
+}
+
+// isIterator reports if object is an iter.Seq or iter.Seq2.
+func isIterator(object any) bool {
+	return iteratorArity(reflect.TypeOf(object)) > 0
+}
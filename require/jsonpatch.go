@@ -0,0 +1,137 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// patchOp is an operation of a JSON Patch (RFC 6902).
+type patchOp struct {
+	Op    string
+	Path  string
+	Value any
+}
+
+// MarshalJSON omits the value of remove operations, and keeps null values
+// of other operations.
+func (op patchOp) MarshalJSON() ([]byte, error) {
+	if op.Op == "remove" {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{op.Op, op.Path})
+	}
+	return json.Marshal(struct {
+		Op    string `json:"op"`
+		Path  string `json:"path"`
+		Value any    `json:"value"`
+	}{op.Op, op.Path, op.Value})
+}
+
+// escapePointer escapes a key as a reference token of a JSON Pointer
+// (RFC 6901).
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+// jsonPatch returns the operations transforming the decoded JSON value
+// from into to. Objects are compared by key, and arrays by index, with
+// elements added or removed at the end.
+func jsonPatch(path string, from, to any) []patchOp {
+	switch from := from.(type) {
+	case map[string]any:
+		to, ok := to.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(from)+len(to))
+		for key := range from {
+			keys = append(keys, key)
+		}
+		for key := range to {
+			if _, ok := from[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		var ops []patchOp
+		for _, key := range keys {
+			keyPath := path + "/" + escapePointer(key)
+			fromValue, inFrom := from[key]
+			toValue, inTo := to[key]
+			switch {
+			case !inTo:
+				ops = append(ops, patchOp{Op: "remove", Path: keyPath})
+			case !inFrom:
+				ops = append(ops, patchOp{Op: "add", Path: keyPath, Value: toValue})
+			default:
+				ops = append(ops, jsonPatch(keyPath, fromValue, toValue)...)
+			}
+		}
+		return ops
+	case []any:
+		to, ok := to.([]any)
+		if !ok {
+			break
+		}
+		var ops []patchOp
+		n := len(from)
+		if len(to) < n {
+			n = len(to)
+		}
+		for i := 0; i < n; i++ {
+			ops = append(ops, jsonPatch(path+"/"+strconv.Itoa(i), from[i], to[i])...)
+		}
+		// remove from the end, so that the indexes stay valid
+		for i := len(from) - 1; i >= len(to); i-- {
+			ops = append(ops, patchOp{Op: "remove", Path: path + "/" + strconv.Itoa(i)})
+		}
+		for i := len(from); i < len(to); i++ {
+			ops = append(ops, patchOp{Op: "add", Path: path + "/-", Value: to[i]})
+		}
+		return ops
+	}
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return []patchOp{{Op: "replace", Path: path, Value: to}}
+}
+
+// formatJSONPatch formats a JSON Patch with one operation per line.
+func formatJSONPatch(ops []patchOp) string {
+	lines := make([]string, len(ops))
+	for i, op := range ops {
+		data, err := json.Marshal(op)
+		if err != nil {
+			data = []byte(err.Error())
+		}
+		lines[i] = "  " + string(data)
+	}
+	return "[\n" + strings.Join(lines, ",\n") + "\n]"
+}
@@ -0,0 +1,79 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "strings"
+
+// KnownIssue runs f, whose failures are caused by a known issue (such as a
+// ticket of an issue tracker), with a TestingT that records failures
+// instead of reporting them, and stops f on fatal failures:
+//
+//	require.KnownIssue(t, "JIRA-123", func(t require.TestingT) {
+//		require.Equal(t, "café", normalize("café"))
+//	})
+//
+// The failures are logged with the issue, without failing the test. If f
+// passes, the test fails, so that the issue is removed from the test once
+// it is fixed. FailNow (and fatal assertions) must only be called from the
+// goroutine running f.
+func KnownIssue(t TestingT, issue string, f func(t TestingT), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "KnownIssue", issue, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	failure := (&collectT{TestingT: t, stop: true}).run(f)
+	if failure == "" {
+		a.failf("known issue %s no longer occurs, remove the KnownIssue call", issue)
+		return
+	}
+	t.Logf("known issue %s, failures are not fatal:\n\t%s", issue, strings.ReplaceAll(failure, "\n", "\n\t"))
+}
+
+// ExpectFail runs f, which is expected to fail for the given reason (such
+// as a known bug), with a TestingT that records failures instead of
+// reporting them, and stops f on fatal failures:
+//
+//	require.ExpectFail(t, "rounding bug in legacy invoices", func(t require.TestingT) {
+//		require.Equal(t, "10.05", legacyTotal(invoice))
+//	})
+//
+// The test fails if f does not fail, with "expected failure no longer
+// occurs", and the failures of f are only logged. Unlike KnownIssue, it
+// documents the failure as the expected behavior of the current code.
+// FailNow (and fatal assertions) must only be called from the goroutine
+// running f.
+func ExpectFail(t TestingT, reason string, f func(t TestingT), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ExpectFail", reason, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	failure := (&collectT{TestingT: t, stop: true}).run(f)
+	if failure == "" {
+		a.failf("expected failure no longer occurs: %s", reason)
+		return
+	}
+	t.Logf("failed as expected (%s):\n\t%s", reason, strings.ReplaceAll(failure, "\n", "\n\t"))
+}
@@ -0,0 +1,129 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// largeValueLen is the length above which strings and byte slices are
+	// not shown whole in the failure messages of Equal, but only around
+	// their first difference.
+	largeValueLen = 1024
+	// diffWindow is the number of bytes shown before and after the first
+	// difference of large values.
+	diffWindow = 32
+	// diffChunk is the size of the chunks compared with bytes.Equal while
+	// looking for the first difference.
+	diffChunk = 4096
+)
+
+// byteString is the constraint of strings and byte slices, so that large
+// values are not copied for conversion.
+type byteString interface {
+	string | []byte
+}
+
+// largeValueMismatch returns the failure message of Equal for strings or
+// byte slices, one of which is longer than largeValueLen, or false.
+func largeValueMismatch(expected, actual any) (string, bool) {
+	switch expected := expected.(type) {
+	case string:
+		if actual, ok := actual.(string); ok && isLarge(expected, actual) {
+			return describeMismatch("strings", expected, actual, true), true
+		}
+	case []byte:
+		if actual, ok := actual.([]byte); ok && isLarge(expected, actual) {
+			return describeMismatch("byte slices", expected, actual, false), true
+		}
+	}
+	return "", false
+}
+
+// isLarge reports if a or b is longer than largeValueLen.
+func isLarge[T byteString](a, b T) bool {
+	return len(a) > largeValueLen || len(b) > largeValueLen
+}
+
+// firstDifference returns the offset of the first differing byte of a and
+// b, or the length of the shorter one if it is a prefix of the other.
+func firstDifference[T byteString](a, b T) int {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	offset := 0
+	// skip the equal chunks, then scan the first differing chunk
+	for offset+diffChunk <= n && string(a[offset:offset+diffChunk]) == string(b[offset:offset+diffChunk]) {
+		offset += diffChunk
+	}
+	for offset < n && a[offset] == b[offset] {
+		offset++
+	}
+	return offset
+}
+
+// diffExcerpt returns the bytes of data around offset, with "..." marking
+// the omitted parts. The excerpt of strings is not cut in the middle of
+// UTF-8 encoded runes.
+func diffExcerpt[T byteString](data T, offset int, isString bool) string {
+	start := offset - diffWindow
+	if start < 0 {
+		start = 0
+	}
+	end := offset + diffWindow
+	if end > len(data) {
+		end = len(data)
+	}
+	if isString {
+		for start > 0 && !utf8.RuneStart(data[start]) {
+			start--
+		}
+		for end < len(data) && !utf8.RuneStart(data[end]) {
+			end++
+		}
+	}
+	excerpt := fmt.Sprintf("%q", data[start:end])
+	if start > 0 {
+		excerpt = "..." + excerpt
+	}
+	if end < len(data) {
+		excerpt += "..."
+	}
+	return excerpt
+}
+
+// describeMismatch shows the lengths of expected and actual, and the bytes
+// around their first difference, instead of the whole values.
+func describeMismatch[T byteString](kind string, expected, actual T, isString bool) string {
+	offset := firstDifference(expected, actual)
+	return fmt.Sprintf(
+		"%s differ at offset %d (expected length %d, actual length %d):\nexpected: %s\nactual  : %s",
+		kind, offset, len(expected), len(actual),
+		diffExcerpt(expected, offset, isString),
+		diffExcerpt(actual, offset, isString),
+	)
+}
@@ -0,0 +1,320 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Matcher is a reusable check of a value, for domain-specific assertions
+// shared across a codebase, see Match.
+type Matcher interface {
+	// Match reports if actual matches. An error means that the match could
+	// not be performed, such as for a value of an unexpected type.
+	Match(actual any) (bool, error)
+	// FailureMessage describes why actual did not match.
+	FailureMessage(actual any) string
+}
+
+// negatedMessager is implemented by matchers that describe why actual
+// matched, for failures of Not. The method is optional, so matchers that
+// do not define it still work with Not.
+type negatedMessager interface {
+	NegatedFailureMessage(actual any) string
+}
+
+// funcMatcher is a Matcher made of a function, see NewMatcher.
+type funcMatcher struct {
+	description string
+	match       func(actual any) (bool, error)
+}
+
+func (m *funcMatcher) Match(actual any) (bool, error) {
+	return m.match(actual)
+}
+
+func (m *funcMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected\n\t%#v\nto %s", actual, m.description)
+}
+
+func (m *funcMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected\n\t%#v\nnot to %s", actual, m.description)
+}
+
+// NewMatcher returns a Matcher calling match, whose failure message is
+// made of the value and description, such as "be a valid order":
+//
+//	func BeValidOrder() require.Matcher {
+//		return require.NewMatcher("be a valid order", func(actual any) (bool, error) {
+//			order, ok := actual.(*Order)
+//			if !ok {
+//				return false, fmt.Errorf("expected *Order, got %T", actual)
+//			}
+//			return order.ID != "" && len(order.Items) > 0, nil
+//		})
+//	}
+func NewMatcher(description string, match func(actual any) (bool, error)) Matcher {
+	return &funcMatcher{description: description, match: match}
+}
+
+// notMatcher is a Matcher inverting another one, see Not.
+type notMatcher struct {
+	matcher Matcher
+}
+
+func (m *notMatcher) Match(actual any) (bool, error) {
+	ok, err := m.matcher.Match(actual)
+	if err != nil {
+		return false, err
+	}
+	return !ok, nil
+}
+
+func (m *notMatcher) FailureMessage(actual any) string {
+	if n, ok := m.matcher.(negatedMessager); ok {
+		return n.NegatedFailureMessage(actual)
+	}
+	msg := m.matcher.FailureMessage(actual)
+	return "Expected the opposite of:\n\t" + strings.ReplaceAll(msg, "\n", "\n\t")
+}
+
+func (m *notMatcher) NegatedFailureMessage(actual any) string {
+	return m.matcher.FailureMessage(actual)
+}
+
+// Not returns a Matcher that matches the values that matcher does not
+// match. Errors of matcher are not inverted, and fail the assertion.
+//
+// The failure message is the NegatedFailureMessage(actual any) string
+// method of matcher if it has one (as the matchers of NewMatcher do), such
+// as "Expected ... not to be a valid order", otherwise the failure message
+// of matcher prefixed with "Expected the opposite of:".
+//
+//	require.Match(t, order, require.Not(BeValidOrder()))
+func Not(matcher Matcher) Matcher {
+	return &notMatcher{matcher: matcher}
+}
+
+// negatedMessage returns the message of matcher for a value that matched
+// it, see Not.
+func negatedMessage(matcher Matcher, actual any) string {
+	return Not(matcher).FailureMessage(actual)
+}
+
+// listMessages formats the failure messages of combined matchers as an
+// indented list.
+func listMessages(msgs []string) string {
+	var sb strings.Builder
+	for _, msg := range msgs {
+		sb.WriteString("\n\t- ")
+		sb.WriteString(strings.ReplaceAll(msg, "\n", "\n\t  "))
+	}
+	return sb.String()
+}
+
+// matchResults records which of combined matchers matched actual, so
+// that the failure messages of AllOf and AnyOf do not match again.
+type matchResults struct {
+	mu      sync.Mutex
+	actual  any
+	matched []bool
+}
+
+// match checks all the matchers, stopping at the first error.
+func (r *matchResults) match(matchers []Matcher, actual any) ([]bool, error) {
+	matched := make([]bool, len(matchers))
+	for i, matcher := range matchers {
+		ok, err := matcher.Match(actual)
+		if err != nil {
+			return nil, err
+		}
+		matched[i] = ok
+	}
+	r.mu.Lock()
+	r.actual, r.matched = actual, matched
+	r.mu.Unlock()
+	return matched, nil
+}
+
+// get returns the results recorded for actual, matching again if the
+// last match was of another value.
+func (r *matchResults) get(matchers []Matcher, actual any) []bool {
+	r.mu.Lock()
+	recorded, matched := r.actual, r.matched
+	r.mu.Unlock()
+	if matched != nil && objectsAreEqual(recorded, actual) {
+		return matched
+	}
+	matched, _ = r.match(matchers, actual)
+	if matched == nil {
+		matched = make([]bool, len(matchers))
+	}
+	return matched
+}
+
+// allOfMatcher is a Matcher combining others, see AllOf.
+type allOfMatcher struct {
+	matchers []Matcher
+	results  matchResults
+}
+
+func (m *allOfMatcher) Match(actual any) (bool, error) {
+	matched, err := m.results.match(m.matchers, actual)
+	if err != nil {
+		return false, err
+	}
+	for _, ok := range matched {
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (m *allOfMatcher) FailureMessage(actual any) string {
+	var msgs []string
+	for i, ok := range m.results.get(m.matchers, actual) {
+		if !ok {
+			msgs = append(msgs, m.matchers[i].FailureMessage(actual))
+		}
+	}
+	return fmt.Sprintf("Expected all of %d matchers to match, but %d did not:", len(m.matchers), len(msgs)) + listMessages(msgs)
+}
+
+func (m *allOfMatcher) NegatedFailureMessage(actual any) string {
+	msgs := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		msgs[i] = negatedMessage(matcher, actual)
+	}
+	return fmt.Sprintf("Expected not all of %d matchers to match, but all did:", len(m.matchers)) + listMessages(msgs)
+}
+
+// AllOf returns a Matcher that matches the values that all of matchers
+// match, with the failure messages of all the matchers that did not match:
+//
+//	require.Match(t, err, require.AllOf(ContainMessage("timeout"), BeOfType[*net.OpError]()))
+//
+// All the matchers are checked, in order, and an error of a matcher fails
+// the assertion.
+func AllOf(matchers ...Matcher) Matcher {
+	return &allOfMatcher{matchers: matchers}
+}
+
+// anyOfMatcher is a Matcher combining others, see AnyOf.
+type anyOfMatcher struct {
+	matchers []Matcher
+	results  matchResults
+}
+
+func (m *anyOfMatcher) Match(actual any) (bool, error) {
+	matched, err := m.results.match(m.matchers, actual)
+	if err != nil {
+		return false, err
+	}
+	for _, ok := range matched {
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *anyOfMatcher) FailureMessage(actual any) string {
+	msgs := make([]string, len(m.matchers))
+	for i, matcher := range m.matchers {
+		msgs[i] = matcher.FailureMessage(actual)
+	}
+	return fmt.Sprintf("Expected any of %d matchers to match, but none did:", len(m.matchers)) + listMessages(msgs)
+}
+
+func (m *anyOfMatcher) NegatedFailureMessage(actual any) string {
+	var msgs []string
+	for i, ok := range m.results.get(m.matchers, actual) {
+		if ok {
+			msgs = append(msgs, negatedMessage(m.matchers[i], actual))
+		}
+	}
+	return fmt.Sprintf("Expected none of %d matchers to match, but %d did:", len(m.matchers), len(msgs)) + listMessages(msgs)
+}
+
+// AnyOf returns a Matcher that matches the values that any of matchers
+// match, with the failure messages of all the matchers if none matched:
+//
+//	require.Match(t, resp.StatusCode, require.AnyOf(BeStatus(200), BeStatus(204)))
+//
+// All the matchers are checked, in order, so that the failure message of
+// Not(AnyOf(...)) lists all those that matched, and an error of a matcher
+// fails the assertion.
+func AnyOf(matchers ...Matcher) Matcher {
+	return &anyOfMatcher{matchers: matchers}
+}
+
+// Match asserts that actual matches matcher, failing with the failure
+// message of matcher, or the error of its Match method.
+//
+//	require.Match(t, order, BeValidOrder())
+func Match(t TestingT, actual any, matcher Matcher, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Match", actual, matcher, msgAndArgs)()
+	}
+	ok, err := matcher.Match(actual)
+	if err != nil {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("matcher error: %v", err))
+		return
+	}
+	if !ok {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(matcher.FailureMessage(actual))
+	}
+}
+
+// Expectation is a value to check with matchers, see Expect.
+type Expectation struct {
+	t      TestingT
+	actual any
+}
+
+// Expect returns an Expectation of actual, for checking it with matchers in
+// the style of Gomega:
+//
+//	require.Expect(t, order).To(BeValidOrder())
+func Expect(t TestingT, actual any) *Expectation {
+	return &Expectation{t: t, actual: actual}
+}
+
+// To asserts that the value matches matcher, see Match.
+func (e *Expectation) To(matcher Matcher, msgAndArgs ...any) {
+	e.t.Helper()
+	Match(e.t, e.actual, matcher, msgAndArgs...)
+}
+
+// ToNot asserts that the value does not match matcher, see Not.
+func (e *Expectation) ToNot(matcher Matcher, msgAndArgs ...any) {
+	e.t.Helper()
+	Match(e.t, e.actual, Not(matcher), msgAndArgs...)
+}
@@ -0,0 +1,205 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// Part is an expected part of a multipart body, for MultipartContains.
+// Empty fields (and nil Content) are not checked.
+type Part struct {
+	// Name is the form field name, the name parameter of Content-Disposition.
+	Name string
+	// FileName is the filename parameter of Content-Disposition.
+	FileName string
+	// ContentType is the media type of the part (parameters are ignored).
+	ContentType string
+	// Content is the content of the part.
+	Content []byte
+}
+
+func (p *Part) String() string {
+	var fields []string
+	if p.Name != "" {
+		fields = append(fields, fmt.Sprintf("name=%q", p.Name))
+	}
+	if p.FileName != "" {
+		fields = append(fields, fmt.Sprintf("filename=%q", p.FileName))
+	}
+	if p.ContentType != "" {
+		fields = append(fields, fmt.Sprintf("type=%q", p.ContentType))
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// parseMultipart returns the parts of a multipart body, with Content-Type
+// header value contentType.
+func parseMultipart(contentType string, body []byte) ([]*Part, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Content-Type %q: %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("Content-Type %q is not multipart", contentType)
+	}
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	var parts []*Part
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(p)
+		if err != nil {
+			return nil, err
+		}
+		partType, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		parts = append(parts, &Part{
+			Name:        p.FormName(),
+			FileName:    p.FileName(),
+			ContentType: partType,
+			Content:     content,
+		})
+	}
+}
+
+// partMismatch returns why actual doesn't match expected, or empty string.
+func partMismatch(expected *Part, actual *Part) string {
+	switch {
+	case expected.FileName != "" && expected.FileName != actual.FileName:
+		return fmt.Sprintf("filename: expected %q, actual %q", expected.FileName, actual.FileName)
+	case expected.ContentType != "" && !strings.EqualFold(expected.ContentType, actual.ContentType):
+		return fmt.Sprintf("content type: expected %q, actual %q", expected.ContentType, actual.ContentType)
+	case expected.Content != nil && !bytes.Equal(expected.Content, actual.Content):
+		return fmt.Sprintf("content: expected %q, actual %q", truncate(expected.Content), truncate(actual.Content))
+	}
+	return ""
+}
+
+// truncate returns the beginning of data for failure messages.
+func truncate(data []byte) string {
+	if len(data) > maxDumpedBody {
+		return string(data[:maxDumpedBody]) + "..."
+	}
+	return string(data)
+}
+
+// MultipartContains asserts that the multipart body, with Content-Type
+// header value contentType, has the expected parts (in any order, and
+// possibly along with other parts). A part is found by Name, or by
+// FileName if Name is empty, and then its other non-empty fields are
+// compared.
+func MultipartContains(t TestingT, contentType string, body []byte, parts []Part, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "MultipartContains", contentType, body, parts, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	actualParts, err := parseMultipart(contentType, body)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Failed to parse multipart body: %v", err))
+		return
+	}
+	var failures []string
+	for i := range parts {
+		expected := &parts[i]
+		var found *Part
+		for _, actual := range actualParts {
+			if expected.Name != "" && actual.Name == expected.Name ||
+				expected.Name == "" && actual.FileName == expected.FileName {
+				found = actual
+				break
+			}
+		}
+		if found == nil {
+			failures = append(failures, fmt.Sprintf("part %s not found", expected))
+			continue
+		}
+		if mismatch := partMismatch(expected, found); mismatch != "" {
+			failures = append(failures, fmt.Sprintf("part %s: %s", expected, mismatch))
+		}
+	}
+	if len(failures) == 0 {
+		return
+	}
+	found := make([]string, len(actualParts))
+	for i, p := range actualParts {
+		found[i] = p.String()
+	}
+	a.Fail(fmt.Sprintf("Multipart body mismatch:\n\t%s\nparts: %s", strings.Join(failures, "\n\t"), strings.Join(found, ", ")))
+}
+
+// RequestMultipartContains asserts that the request has a multipart body
+// with the expected parts, see MultipartContains. The body of req is read
+// and replaced, so it can still be read by the caller.
+func RequestMultipartContains(t TestingT, req *http.Request, parts []Part, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "RequestMultipartContains", req, parts, msgAndArgs)()
+	}
+	body, err := readAndRestore(&req.Body)
+	if err != nil {
+		newAsserter(t, msgAndArgs).Fail(fmt.Sprintf("Failed to read request body: %v", err))
+		return
+	}
+	MultipartContains(t, req.Header.Get("Content-Type"), body, parts, msgAndArgs...)
+}
+
+// ResponseMultipartContains asserts that the response has a multipart body
+// with the expected parts, see MultipartContains. The body of resp is read
+// and replaced, so it can still be read by the caller.
+func ResponseMultipartContains(t TestingT, resp *http.Response, parts []Part, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ResponseMultipartContains", resp, parts, msgAndArgs)()
+	}
+	body, err := readAndRestore(&resp.Body)
+	if err != nil {
+		newAsserter(t, msgAndArgs).Fail(fmt.Sprintf("Failed to read response body: %v", err))
+		return
+	}
+	MultipartContains(t, resp.Header.Get("Content-Type"), body, parts, msgAndArgs...)
+}
+
+// readAndRestore reads all of body, and replaces it with a reader of the
+// same content.
+func readAndRestore(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
@@ -0,0 +1,115 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "reflect"
+
+// Must returns a function that fails the test if err is not nil, and
+// otherwise returns value. It collapses the call / NoError / use pattern
+// into one line:
+//
+//	data := require.Must(os.ReadFile(path))(t)
+//
+// Go doesn't allow passing a multi-valued call along with other arguments,
+// like require.Must(t, os.ReadFile(path)), hence the separate call with t.
+func Must[T any](value T, err error) func(t TestingT, msgAndArgs ...any) T {
+	return func(t TestingT, msgAndArgs ...any) T {
+		t.Helper()
+		newAsserter(t, msgAndArgs).NotErr(err)
+		return value
+	}
+}
+
+// Must2 is like Must, for functions returning two values and an error.
+func Must2[A any, B any](a A, b B, err error) func(t TestingT, msgAndArgs ...any) (A, B) {
+	return func(t TestingT, msgAndArgs ...any) (A, B) {
+		t.Helper()
+		newAsserter(t, msgAndArgs).NotErr(err)
+		return a, b
+	}
+}
+
+// MustOK is like Must, for functions returning a value and a bool, such
+// as lookups, and fails the test if ok is false.
+func MustOK[T any](value T, ok bool) func(t TestingT, msgAndArgs ...any) T {
+	return func(t TestingT, msgAndArgs ...any) T {
+		t.Helper()
+		a := newAsserter(t, msgAndArgs)
+		if !ok {
+			a.failf("expected ok, but got false for value: %v", value)
+		}
+		return value
+	}
+}
+
+// Got returns value if err is nil, and otherwise fails the test with err
+// and the context of the call: the location and source line of the call
+// of Got, so the failures in fixtures and setup code are self-describing:
+//
+//	user, err := store.CreateUser(ctx, "alice")
+//	user = require.Got(t, user, err, "creating fixture user")
+func Got[T any](t TestingT, value T, err error, msgAndArgs ...any) T {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Got", value, err, msgAndArgs)()
+	}
+	if isNil(err) {
+		return value
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.failf("unexpected error: %v\n\tcall: %s", err, callContext())
+	return value
+}
+
+// FromMap returns the value of key in map m, failing if the key is not
+// present.
+func FromMap[K comparable, V any](t TestingT, m map[K]V, key K, msgAndArgs ...any) V {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FromMap", m, key, msgAndArgs)()
+	}
+	value, ok := m[key]
+	if !ok {
+		a := newAsserter(t, msgAndArgs)
+		a.failf("expected key %#v to be present in %T of %d elements", key, m, len(m))
+	}
+	return value
+}
+
+// TypeAssert returns value as type T, failing if it is not of type T (or
+// does not implement T, if T is an interface).
+//
+//	err := require.TypeAssert[*os.PathError](t, value)
+func TypeAssert[T any](t TestingT, value any, msgAndArgs ...any) T {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "TypeAssert", value, msgAndArgs)()
+	}
+	result, ok := value.(T)
+	if !ok {
+		a := newAsserter(t, msgAndArgs)
+		a.failf("expected value of type '%s', but got '%T': %v", reflect.TypeOf((*T)(nil)).Elem(), value, value)
+	}
+	return result
+}
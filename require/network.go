@@ -0,0 +1,96 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	// dialRetryMin and dialRetryMax are the bounds of the delay between
+	// attempts of DialSucceedsWithin, which doubles after each attempt.
+	dialRetryMin = 10 * time.Millisecond
+	dialRetryMax = 500 * time.Millisecond
+)
+
+// DialSucceedsWithin asserts that a connection to addr on the named network
+// (see net.Dial) can be established within timeout, retrying failed
+// attempts, and closes the connection. It is meant for waiting for servers
+// and containers started by integration tests to become ready.
+// Like Eventually, timeout is capped at the test deadline.
+func DialSucceedsWithin(t TestingT, network string, addr string, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "DialSucceedsWithin", network, addr, timeout, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	timeout, capped := capToDeadline(t, span.capWait(timeout))
+	start := time.Now()
+	deadline := start.Add(timeout)
+	delay := dialRetryMin
+	attempts := 0
+	var lastErr error
+	for {
+		attempts++
+		conn, err := net.DialTimeout(network, addr, time.Until(deadline))
+		if err == nil {
+			conn.Close()
+			span.end(a)
+			return
+		}
+		lastErr = err
+		if time.Until(deadline) <= delay {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+		if delay > dialRetryMax {
+			delay = dialRetryMax
+		}
+	}
+	if !span.end(a) {
+		return
+	}
+	reason := fmt.Sprintf("could not dial %s %s within %v", network, addr, timeout)
+	if capped {
+		reason = fmt.Sprintf("test deadline would be exceeded: could not dial %s %s in %v before the deadline", network, addr, timeout)
+	}
+	a.Fail(fmt.Sprintf("%s (%d attempts), last error: %v", reason, attempts, lastErr))
+}
+
+// TCPPortOpen asserts that a TCP connection to addr (host:port) can be
+// established within timeout, see DialSucceedsWithin.
+func TCPPortOpen(t TestingT, addr string, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "TCPPortOpen", addr, timeout, msgAndArgs)()
+	}
+	DialSucceedsWithin(t, "tcp", addr, timeout, msgAndArgs...)
+}
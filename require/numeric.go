@@ -0,0 +1,110 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// numericValue is a number of any Go numeric type, keeping its value
+// exactly.
+type numericValue struct {
+	kind  byte // 'i' for signed integers, 'u' for unsigned ones, 'f' for floats
+	i     int64
+	u     uint64
+	f     float64
+	valid bool
+}
+
+// toNumeric returns the numeric value of v, which is invalid if v is not of
+// an integer or floating-point kind.
+func toNumeric(v reflect.Value) numericValue {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return numericValue{kind: 'i', i: v.Int(), valid: true}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return numericValue{kind: 'u', u: v.Uint(), valid: true}
+	case reflect.Float32, reflect.Float64:
+		return numericValue{kind: 'f', f: v.Float(), valid: true}
+	}
+	return numericValue{}
+}
+
+// floatEqualsInt reports if f is exactly the integer i.
+func floatEqualsInt(f float64, i int64) bool {
+	// -2^63 is exactly representable, 2^63 is out of range
+	return f == math.Trunc(f) && f >= -(1<<63) && f < 1<<63 && int64(f) == i
+}
+
+// floatEqualsUint reports if f is exactly the unsigned integer u.
+func floatEqualsUint(f float64, u uint64) bool {
+	return f == math.Trunc(f) && f >= 0 && f < 1<<64 && uint64(f) == u
+}
+
+// numericEqual reports if the numbers are equal by value, without the
+// overflow or rounding of converting one to the type of the other.
+func numericEqual(x, y numericValue) bool {
+	if x.kind > y.kind {
+		x, y = y, x
+	}
+	// x.kind <= y.kind, in order 'f' < 'i' < 'u'
+	switch {
+	case x.kind == y.kind:
+		return x.i == y.i && x.u == y.u && x.f == y.f
+	case x.kind == 'f' && y.kind == 'i':
+		return floatEqualsInt(x.f, y.i)
+	case x.kind == 'f' && y.kind == 'u':
+		return floatEqualsUint(x.f, y.u)
+	default: // 'i' and 'u'
+		return x.i >= 0 && uint64(x.i) == y.u
+	}
+}
+
+// NumericEqual asserts that expected and actual are numbers of any integer
+// or floating-point types with the same value, such as int(2), uint8(2) and
+// float64(2.0), as decoded from JSON into an interface{}. Unlike a
+// conversion, the comparison does not overflow or round, so int64(-1) and
+// uint64(math.MaxUint64) are not equal, nor are 0.5 and 0.
+func NumericEqual(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NumericEqual", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedNum := toNumeric(reflect.ValueOf(expected))
+	if !expectedNum.valid {
+		a.Fail(fmt.Sprintf("expected value %#v (%T) is not a number", expected, expected))
+		return
+	}
+	actualNum := toNumeric(reflect.ValueOf(actual))
+	if !actualNum.valid {
+		a.Fail(fmt.Sprintf("actual value %#v (%T) is not a number", actual, actual))
+		return
+	}
+	if !numericEqual(expectedNum, actualNum) {
+		a.Fail(fmt.Sprintf("Not equal:\nexpected: %v (%T)\nactual  : %v (%T)", expected, expected, actual, actual))
+	}
+}
@@ -0,0 +1,131 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// compareValues compares two values of the same ordered kind (integers,
+// floats, strings and time.Time), returning -1, 0 or 1, and false if they
+// can not be compared.
+func compareValues(v1, v2 reflect.Value) (int, bool) {
+	if !v1.IsValid() || !v2.IsValid() || v1.Type() != v2.Type() {
+		return 0, false
+	}
+	if v1.Type() == reflect.TypeOf(time.Time{}) && v1.CanInterface() {
+		return v1.Interface().(time.Time).Compare(v2.Interface().(time.Time)), true
+	}
+	switch v1.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(v1.Int(), v2.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(v1.Uint(), v2.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(v1.Float(), v2.Float()), true
+	case reflect.String:
+		return compareOrdered(v1.String(), v2.String()), true
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | uint64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// checkOrdered fails if the consecutive elements of the array or slice
+// object do not compare with one of the allowed results, with failMessage
+// formatted with the two elements.
+func checkOrdered(a *asserter, object any, allowed []int, failMessage string) {
+	a.t.Helper()
+	value := reflect.ValueOf(object)
+	if !isListKind(value.Kind()) {
+		a.Fail(fmt.Sprintf("%#v has an unsupported type %T, expecting array or slice", object, object))
+		return
+	}
+	for i := 1; i < value.Len(); i++ {
+		prev, cur := value.Index(i-1), value.Index(i)
+		result, ok := compareValues(prev, cur)
+		if !ok {
+			a.Fail(fmt.Sprintf("Can not compare type \"%v\"", prev.Type()))
+			return
+		}
+		found := false
+		for _, r := range allowed {
+			found = found || r == result
+		}
+		if !found {
+			a.Fail(fmt.Sprintf(failMessage, prev, cur))
+			return
+		}
+	}
+}
+
+// IsIncreasing asserts that the elements of the array or slice object are
+// strictly increasing.
+func IsIncreasing(t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsIncreasing", object, msgAndArgs)()
+	}
+	checkOrdered(newAsserter(t, msgAndArgs), object, []int{-1}, "\"%v\" is not less than \"%v\"")
+}
+
+// IsNonIncreasing asserts that the elements of the array or slice object
+// are not increasing.
+func IsNonIncreasing(t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsNonIncreasing", object, msgAndArgs)()
+	}
+	checkOrdered(newAsserter(t, msgAndArgs), object, []int{1, 0}, "\"%v\" is not greater than or equal to \"%v\"")
+}
+
+// IsDecreasing asserts that the elements of the array or slice object are
+// strictly decreasing.
+func IsDecreasing(t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsDecreasing", object, msgAndArgs)()
+	}
+	checkOrdered(newAsserter(t, msgAndArgs), object, []int{1}, "\"%v\" is not greater than \"%v\"")
+}
+
+// IsNonDecreasing asserts that the elements of the array or slice object
+// are not decreasing.
+func IsNonDecreasing(t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsNonDecreasing", object, msgAndArgs)()
+	}
+	checkOrdered(newAsserter(t, msgAndArgs), object, []int{-1, 0}, "\"%v\" is not less than or equal to \"%v\"")
+}
@@ -0,0 +1,56 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"testing"
+)
+
+func TestIsIncreasing(t *testing.T) {
+	tests := []struct {
+		name   string
+		assert func(t TestingT, object any, msgAndArgs ...any)
+		object any
+		failed bool
+	}{
+		{"IsIncreasing", IsIncreasing, []int{1, 2, 3}, false},
+		{"IsIncreasing", IsIncreasing, []int{1, 1, 3}, true},
+		{"IsNonIncreasing", IsNonIncreasing, []string{"c", "b", "b"}, false},
+		{"IsNonIncreasing", IsNonIncreasing, []string{"a", "b"}, true},
+		{"IsDecreasing", IsDecreasing, [3]float64{3, 2, 1}, false},
+		{"IsDecreasing", IsDecreasing, []float64{3, 3}, true},
+		{"IsNonDecreasing", IsNonDecreasing, []uint{1, 1, 2}, false},
+		{"IsNonDecreasing", IsNonDecreasing, []uint{2, 1}, true},
+		{"IsIncreasing", IsIncreasing, []int{}, false},
+		{"IsIncreasing", IsIncreasing, 3, true},
+		{"IsIncreasing", IsIncreasing, []struct{}{{}, {}}, true},
+	}
+	for _, tt := range tests {
+		ft := newFakeT(t)
+		tt.assert(ft, tt.object)
+		if ft.Failed() != tt.failed {
+			t.Errorf("%s(%#v) failed = %v, expected %v", tt.name, tt.object, ft.Failed(), tt.failed)
+		}
+	}
+}
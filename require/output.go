@@ -0,0 +1,134 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// captureMutex serializes output captures, since they replace the global
+// os.Stdout and os.Stderr.
+var captureMutex sync.Mutex
+
+// readPipe starts copying r into buf, and returns a channel closed when
+// r reaches end of file.
+func readPipe(r *os.File, buf *bytes.Buffer) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = io.Copy(buf, r)
+		r.Close()
+	}()
+	return done
+}
+
+// CaptureOutput calls f while os.Stdout and os.Stderr are redirected to
+// pipes, and returns what was written to them. The output of the standard
+// logger (the log package functions) is captured as part of stderr.
+//
+// The originals are restored when f returns, panics, or stops the test
+// with FailNow. Since os.Stdout and os.Stderr are global, CaptureOutput
+// must not be used in parallel tests that write to them.
+func CaptureOutput(t TestingT, f func()) (stdout string, stderr string) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "CaptureOutput", f)()
+	}
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		Fail(t, fmt.Sprintf("could not create pipe: %v", err))
+		return
+	}
+	errR, errW, err := os.Pipe()
+	if err != nil {
+		outR.Close()
+		outW.Close()
+		Fail(t, fmt.Sprintf("could not create pipe: %v", err))
+		return
+	}
+
+	var outBuf, errBuf bytes.Buffer
+	outDone := readPipe(outR, &outBuf)
+	errDone := readPipe(errR, &errBuf)
+
+	origStdout, origStderr := os.Stdout, os.Stderr
+	origLogOutput := log.Writer()
+	os.Stdout, os.Stderr = outW, errW
+	log.SetOutput(errW)
+
+	func() {
+		defer func() {
+			os.Stdout, os.Stderr = origStdout, origStderr
+			log.SetOutput(origLogOutput)
+			outW.Close()
+			errW.Close()
+			<-outDone
+			<-errDone
+		}()
+		f()
+	}()
+	return outBuf.String(), errBuf.String()
+}
+
+// OutputContains asserts that the output of f, written to os.Stdout,
+// os.Stderr or the standard logger, contains the string contains.
+func OutputContains(t TestingT, f func(), contains string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "OutputContains", f, contains, msgAndArgs)()
+	}
+	stdout, stderr := CaptureOutput(t, f)
+	if strings.Contains(stdout, contains) || strings.Contains(stderr, contains) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("output does not contain %q\nstdout:\n%s\nstderr:\n%s", contains, tail(stdout), tail(stderr)))
+}
+
+// OutputMatchesGolden asserts that the standard output of f matches the
+// content of the golden file at goldenPath. The golden file is written
+// instead if opts.Update is set, or go test is run with -demand.update.
+func OutputMatchesGolden(t TestingT, f func(), goldenPath string, opts GoldenOptions, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "OutputMatchesGolden", f, goldenPath, opts, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	stdout, _ := CaptureOutput(t, f)
+	matchGolden(a, goldenPath, []byte(stdout), opts, nil)
+	span.end(a)
+}
@@ -0,0 +1,153 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// allocsRuns is the number of runs that allocations are averaged over
+// by MaxAllocs.
+const allocsRuns = 100
+
+// MaxAllocs asserts that f performs at most n heap allocations per run,
+// on average, as measured by testing.AllocsPerRun.
+//
+// Note that testing.AllocsPerRun sets GOMAXPROCS to 1 while running, and
+// that allocations by other goroutines are counted too.
+func MaxAllocs(t TestingT, n int, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "MaxAllocs", n, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	allocs := testing.AllocsPerRun(allocsRuns, f)
+	if allocs > float64(n) {
+		a.Fail(fmt.Sprintf("expected at most %d allocations per run, but got %v", n, allocs))
+	}
+}
+
+// heapGrowthRuns is the number of runs of the workload of NoHeapGrowth.
+const heapGrowthRuns = 100
+
+// liveHeap returns the bytes of live heap objects, after a full garbage
+// collection.
+func liveHeap() uint64 {
+	// the second collection frees objects whose finalizers were run by
+	// the first one
+	runtime.GC()
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
+
+// NoHeapGrowth asserts that running f repeatedly does not grow the live
+// heap by more than tolerance bytes, as a pragmatic leak detector for
+// caches, pools and other code that should reach a steady state.
+//
+// f is run once to warm up (filling caches and pools), then the live heap
+// is measured after a garbage collection, f is run 100 times, and the live
+// heap is measured again. Since the heap is shared by the whole process,
+// tests running in parallel (and goroutines left over from other tests)
+// can make it grow too.
+func NoHeapGrowth(t TestingT, f func(), tolerance uint64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NoHeapGrowth", f, tolerance, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	f()
+	before := liveHeap()
+	for i := 0; i < heapGrowthRuns; i++ {
+		f()
+	}
+	after := liveHeap()
+	if after <= before || after-before <= tolerance {
+		return
+	}
+	a.Fail(fmt.Sprintf(
+		"expected live heap to grow by at most %d bytes over %d runs, but it grew by %d bytes (from %d to %d)",
+		tolerance, heapGrowthRuns, after-before, before, after,
+	))
+}
+
+// TimingOptions configures the runs of FasterThanWith.
+type TimingOptions struct {
+	// WarmUp is the number of runs before the measured runs.
+	WarmUp int
+
+	// Runs is the number of measured runs, the fastest of which is compared
+	// with the budget. Zero means a single run.
+	Runs int
+}
+
+// FasterThan asserts that f completes within the wall-clock duration d.
+// It is meant for guarding against gross regressions (for example an
+// algorithm becoming quadratic), not for precise measurements.
+// See FasterThanWith for warm-up and best-of-N runs.
+func FasterThan(t TestingT, d time.Duration, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FasterThan", d, f, msgAndArgs)()
+	}
+	FasterThanWith(t, d, TimingOptions{}, f, msgAndArgs...)
+}
+
+// FasterThanWith is like FasterThan, but runs f opts.WarmUp times first,
+// then measures opts.Runs runs and compares the fastest with d.
+func FasterThanWith(t TestingT, d time.Duration, opts TimingOptions, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FasterThanWith", d, opts, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	for i := 0; i < opts.WarmUp; i++ {
+		f()
+	}
+	runs := opts.Runs
+	if runs < 1 {
+		runs = 1
+	}
+	var best time.Duration
+	for i := 0; i < runs; i++ {
+		start := time.Now()
+		f()
+		elapsed := time.Since(start)
+		if i == 0 || elapsed < best {
+			best = elapsed
+		}
+		if best <= d {
+			return
+		}
+	}
+	if runs == 1 {
+		a.Fail(fmt.Sprintf("expected function to complete within %v, but it took %v", d, best))
+		return
+	}
+	a.Fail(fmt.Sprintf("expected function to complete within %v, but the fastest of %d runs took %v", d, runs, best))
+}
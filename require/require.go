@@ -23,17 +23,24 @@
 
 package require
 
+//go:generate go run ../internal/gen require
+
 import (
 	"cmp"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
-	"github.com/ilius/is/v2"
+	"github.com/ilius/demand/internal/core"
+	"github.com/ilius/demand/internal/diff"
 )
 
 type PanicTestFunc func()
@@ -41,96 +48,219 @@ type Comparison func() (success bool)
 
 type TestingT = testing.TB
 
-func addMsg(is *is.Is, msgAndArgs []any) {
-	if len(msgAndArgs) > 0 {
-		format := msgAndArgs[0].(string)
-		is.AddMsg(format, msgAndArgs[1:]...)
-	}
-}
-
 func Condition(t TestingT, comp Comparison, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.True(comp())
-}
-
-func Conditionf(t TestingT, comp Comparison, msg string, args ...any) {
-	is := is.New(t)
-	is.AddMsg(msg, args...)
-	is.True(comp())
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Condition", comp, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.True(comp())
+}
+
+// Contains asserts that s contains the element contains, where s is:
+//   - a value with a Contains method taking a single parameter that
+//     contains is assignable (or convertible) to, and returning bool, such
+//     as a set or an interval type; the method decides
+//   - a string or []byte, containing contains (a string or []byte) as a
+//     substring, or the rune contains (for strings)
+//   - an array, slice or iter.Seq with an element equal to contains
+//   - a map or iter.Seq2 with the key contains, see also ContainsKey and
+//     ContainsValue
+//   - a Container with an element equal to contains
+func Contains(t TestingT, s any, contains any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Contains", s, contains, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Contains(s, contains)
 }
 
-func Contains(t TestingT, s any, contains any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Contains(s, contains)
+// ContainsKey asserts that the map m has the given key.
+func ContainsKey(t TestingT, m any, key any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContainsKey", m, key, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	mValue := reflect.ValueOf(m)
+	if mValue.Kind() != reflect.Map {
+		a.Fail(fmt.Sprintf("%#v has an unsupported type %T, expecting map", m, m))
+		return
+	}
+	if !mapContains(mValue, key, false) {
+		a.Fail(fmt.Sprintf("%#v expected to contain key %#v", m, key))
+	}
 }
 
-func Containsf(t TestingT, s any, contains any, msg string, args ...any) {
-	is := is.New(t)
-	is.AddMsg(msg, args...)
-	is.Contains(s, contains)
+// ContainsValue asserts that the map m has a value equal to the given value.
+func ContainsValue(t TestingT, m any, value any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ContainsValue", m, value, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	mValue := reflect.ValueOf(m)
+	if mValue.Kind() != reflect.Map {
+		a.Fail(fmt.Sprintf("%#v has an unsupported type %T, expecting map", m, m))
+		return
+	}
+	if !mapContains(mValue, value, true) {
+		a.Fail(fmt.Sprintf("%#v expected to contain value %#v", m, value))
+	}
 }
 
+// ElementsMatch asserts that the arrays, slices, maps, iterators or
+// Containers have the same elements, regardless of their order, counting
+// repeated elements. Two maps (or iter.Seq2 iterators) are compared as multisets of key/value
+// pairs, and an array, slice or iter.Seq is compared with the values of a
+// map whose element type is the same as its element type.
 func ElementsMatch(t TestingT, listA any, listB any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ElementsMatch", listA, listB, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 	if isEmpty(listA) && isEmpty(listB) {
 		return
 	}
-	if !isList(listA) {
-		is.Fail(fmt.Sprintf("%q has an unsupported type %T, expecting array or slice", listA, listA))
+	if !isCollection(listA) {
+		a.Fail(fmt.Sprintf("%#v has an unsupported type %T, expecting array, slice, map, iterator or Container", listA, listA))
 		return
 	}
-	if !isList(listB) {
-		is.Fail(fmt.Sprintf("%q has an unsupported type %T, expecting array or slice", listB, listB))
+	if !isCollection(listB) {
+		a.Fail(fmt.Sprintf("%#v has an unsupported type %T, expecting array, slice, map, iterator or Container", listB, listB))
 		return
 	}
-	extraA, extraB := diffLists(listA, listB)
+	typeA, typeB := reflect.TypeOf(listA), reflect.TypeOf(listB)
+	elemA, elemB := collectionElemType(typeA), collectionElemType(typeB)
+	if isMapLike(typeA) != isMapLike(typeB) && elemA != elemB {
+		a.Fail(fmt.Sprintf("element types %v and %v of %T and %T are different", elemA, elemB, listA, listB))
+		return
+	}
+	entries := isMapLike(typeA) && isMapLike(typeB)
+	elementsA, err := collectionElements(listA, entries)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	elementsB, err := collectionElements(listB, entries)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	extraA, extraB := diffLists(elementsA, elementsB)
 
 	if len(extraA) == 0 && len(extraB) == 0 {
 		return
 	}
-	is.Fail(fmt.Sprintf("lists are not equal, %d extra in first, %d extra in second", len(extraA), len(extraB)))
+	a.Fail(fmt.Sprintf(
+		"lists are not equal, %d extra in first, %d extra in second\n\textra in first : %v\n\textra in second: %v",
+		len(extraA), len(extraB), extraA, extraB,
+	))
 }
 
-func ElementsMatchf(t TestingT, listA any, listB any, msg string, args ...any) {
-	ElementsMatch(t, listA, listB, append([]any{msg}, args...)...)
+// EmptyOptions configures EmptyWith and NotEmptyWith.
+type EmptyOptions struct {
+	// IgnoreWhitespace considers strings (and pointers to strings) that
+	// contain only whitespace as empty.
+	IgnoreWhitespace bool
 }
 
+// isEmptyWith is like isEmpty, configured by opts.
+func isEmptyWith(object any, opts EmptyOptions) bool {
+	if opts.IgnoreWhitespace {
+		value := reflect.ValueOf(object)
+		for value.Kind() == reflect.Ptr && !value.IsNil() {
+			value = value.Elem()
+		}
+		if value.Kind() == reflect.String {
+			return strings.TrimSpace(value.String()) == ""
+		}
+	}
+	return isEmpty(object)
+}
+
+// Empty asserts that the object is empty: nil, a channel, map or slice
+// with no elements, a value whose Len() int method returns 0 (such as
+// bytes.Buffer), a pointer to an empty value, a value whose IsZero
+// method returns true (such as time.Time), or the zero value of its type
+// for other types. Arrays are empty only if all their elements are zero
+// (not if their length is zero).
 func Empty(t TestingT, object any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	if !isEmpty(object) {
-		is.Fail(fmt.Sprintf("Should be empty, but was %v", object))
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Empty", object, msgAndArgs)()
 	}
+	EmptyWith(t, object, EmptyOptions{}, msgAndArgs...)
 }
 
-func Equal(t TestingT, expected any, actual any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Equal(actual, expected)
+// EmptyWith is like Empty, configured by opts.
+func EmptyWith(t TestingT, object any, opts EmptyOptions, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EmptyWith", object, opts, msgAndArgs)()
+	}
+	if !isEmptyWith(object, opts) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("Should be empty, but was %s", describeValue(object)))
+	}
 }
 
-func EqualError(t TestingT, theError error, errString string, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.ErrMsg(theError, errString)
+func Equal(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Equal", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Equal(actual, expected)
+}
+
+// EqualT is like Equal, but expected and actual must be of the same type,
+// so type mismatches (such as int vs int64) are compile errors, and values
+// are compared with == instead of reflection.
+//
+// Values of interface types (whose dynamic types may not be comparable)
+// and types implementing Equaler fall back to the comparison of Equal.
+func EqualT[T comparable](t TestingT, expected T, actual T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualT", expected, actual, msgAndArgs)()
+	}
+	if equalT(expected, actual) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.recordValues(expected, actual)
+	a.failf("got '%v' (%T). expected '%v' (%T)", actual, actual, expected, expected)
 }
 
-func EqualErrorf(t TestingT, theError error, errString string, msg string, args ...any) {
-	EqualError(t, theError, errString, append([]any{msg}, args...)...)
+func EqualError(t TestingT, theError error, errString string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualError", theError, errString, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.ErrMsg(theError, errString)
 }
 
+// EqualExportedValues asserts that the structs (or pointers to structs)
+// have equal exported fields, at any depth, ignoring unexported fields.
+// Otherwise values are compared like reflect.DeepEqual, so nil and empty
+// slices or maps are not equal. The failure message shows the path of the
+// first difference.
 func EqualExportedValues(t TestingT, expected any, actual any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualExportedValues", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 
 	aType := reflect.TypeOf(expected)
 	bType := reflect.TypeOf(actual)
 
 	if aType != bType {
-		is.Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType))
+		a.Fail(fmt.Sprintf("Types expected to match exactly\n\t%v != %v", aType, bType))
 		return
 	}
 
@@ -142,277 +272,504 @@ func EqualExportedValues(t TestingT, expected any, actual any, msgAndArgs ...any
 	}
 
 	if aType.Kind() != reflect.Struct {
-		is.Fail(fmt.Sprintf("Types expected to both be struct or pointer to struct \n\t%v != %v", aType.Kind(), reflect.Struct))
+		a.Fail(fmt.Sprintf("Types expected to both be struct or pointer to struct \n\t%v != %v", aType.Kind(), reflect.Struct))
 		return
 	}
 
 	if bType.Kind() != reflect.Struct {
-		is.Fail(fmt.Sprintf("Types expected to both be struct or pointer to struct \n\t%v != %v", bType.Kind(), reflect.Struct))
+		a.Fail(fmt.Sprintf("Types expected to both be struct or pointer to struct \n\t%v != %v", bType.Kind(), reflect.Struct))
 		return
 	}
 
-	expected = copyExportedFields(expected)
-	actual = copyExportedFields(actual)
-
-	if !objectsAreEqualValues(expected, actual) {
-		// diff := diff(expected, actual)
-		// expected, actual = formatUnequalValues(expected, actual)
-		is.Fail(fmt.Sprintf(
-			"Not equal (comparing only exported fields): \nexpected: %s\nactual  : %s",
-			expected, actual,
-			// diff,
-		))
+	c := &comparer{exportedOnly: true, strictNil: true}
+	if diff := c.compare("", reflect.ValueOf(expected), reflect.ValueOf(actual)); diff != "" {
+		a.Fail("Not equal (comparing only exported fields): " + diff)
 	}
 }
 
-func EqualExportedValuesf(t TestingT, expected any, actual any, msg string, args ...any) {
-	EqualExportedValues(t, expected, actual, append([]any{msg}, args...)...)
-}
-
+// EqualValues asserts that two objects are equal, or convertible to the
+// type of the other and equal after conversion, so that int32(5) and
+// int64(5) are equal.
 func EqualValues(t TestingT, expected any, actual any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Equal(actual, expected)
-	is.EqualType(expected, actual)
-}
-
-func EqualValuesf(t TestingT, expected any, actual any, msg string, args ...any) {
-	EqualValues(t, expected, actual, append([]any{msg}, args...)...)
-}
-
-func Equalf(t TestingT, expected any, actual any, msg string, args ...any) {
-	Equal(t, expected, actual, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EqualValues", expected, actual, msgAndArgs)()
+	}
+	if objectsAreEqualValues(expected, actual) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.recordValues(expected, actual)
+	a.Fail(withDiff(fmt.Sprintf(
+		"Not equal:\nexpected: %s (%T)\nactual  : %s (%T)",
+		formatValue(reflect.ValueOf(expected)), expected, formatValue(reflect.ValueOf(actual)), actual,
+	), expected, actual))
 }
 
 func Error(t TestingT, err error, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Err(err)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Error", err, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Err(err)
 }
 
 // ErrorAs asserts that at least one of the errors in err's chain matches target, and if so, sets target to that error value.
 // This is a wrapper for errors.As.
 func ErrorAs(t TestingT, err error, target any, msgAndArgs ...any) {
-	// TODO
-	is := is.New(t)
-	is.Fail("unsupported function")
-}
-
-func ErrorAsf(t TestingT, err error, target any, msg string, args ...any) {
-	ErrorAs(t, err, target, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ErrorAs", err, target, msgAndArgs)()
+	}
+	if errors.As(err, target) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf(
+		"Should be in error chain:\nexpected: %q\nin chain: %s",
+		reflect.TypeOf(target).Elem().String(), buildErrorChainString(err),
+	))
 }
 
+// ErrorContains asserts that a function returned an error (i.e. not `nil`)
+// and that the error message contains the specified substring.
 func ErrorContains(t TestingT, theError error, contains string, msgAndArgs ...any) {
-	// TODO
-	is := is.New(t)
-	is.Fail("unsupported function")
-}
-
-func ErrorContainsf(t TestingT, theError error, contains string, msg string, args ...any) {
-	ErrorContains(t, theError, contains, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ErrorContains", theError, contains, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if theError == nil {
+		a.Fail(fmt.Sprintf("An error is expected but got nil.\nexpected error containing %q", contains))
+		return
+	}
+	if !strings.Contains(theError.Error(), contains) {
+		a.Fail(fmt.Sprintf("Error %q does not contain %q", theError.Error(), contains))
+	}
 }
 
 // ErrorIs asserts that at least one of the errors in err's chain matches target.
 // This is a wrapper for errors.Is.
 func ErrorIs(t TestingT, err error, target error, msgAndArgs ...any) {
-	// TODO
-	is := is.New(t)
-	is.Fail("unsupported function")
-}
-
-func ErrorIsf(t TestingT, err error, target error, msg string, args ...any) {
-	ErrorIs(t, err, target, append([]any{msg}, args...)...)
-}
-
-func Errorf(t TestingT, err error, msg string, args ...any) {
-	Error(t, err, append([]any{msg}, args...)...)
-}
-
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "ErrorIs", err, target, msgAndArgs)()
+	}
+	if errors.Is(err, target) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedText := "<nil>"
+	if target != nil {
+		expectedText = target.Error()
+	}
+	a.Fail(fmt.Sprintf(
+		"Target error should be in err chain:\nexpected: %q\nin chain: %s",
+		expectedText, buildErrorChainString(err),
+	))
+}
+
+// Eventually asserts that given condition will be met in waitFor time,
+// periodically checking target function each tick.
+// waitFor is capped at the test deadline (see -timeout flag of go test),
+// in which case the test fails with a clear message instead of timing out.
+//
+// Eventually, Never and the other polling assertions can be used in a
+// bubble of testing/synctest, where they wait with its fake clock, without
+// leaving goroutines running after they return.
 func Eventually(t TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
-	// TODO
-	is := is.New(t)
-	is.Fail("unsupported function")
-}
-
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Eventually", condition, waitFor, tick, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	waitFor, capped := capToDeadline(t, span.capWait(waitFor))
+	satisfied := pollCondition(findClock(t), condition, waitFor, tick)
+	if !span.end(a) || satisfied {
+		return
+	}
+	if capped {
+		a.Fail(fmt.Sprintf("test deadline would be exceeded: condition was not satisfied in %v before the deadline", waitFor))
+		return
+	}
+	a.Fail("condition never satisfied")
+}
+
+// EventuallyWithT asserts that the assertions of condition pass in waitFor
+// time, periodically calling condition each tick, like Eventually.
+// condition is called with a TestingT that records the failures of the
+// assertions instead of reporting them (and stops the call on fatal
+// failures), and is satisfied when a call has no failure. The failures of
+// the last call are reported if it is never satisfied:
+//
+//	require.EventuallyWithT(t, func(c require.TestingT) {
+//		status, err := client.Status()
+//		require.NoError(c, err)
+//		require.Equal(c, "ready", status)
+//	}, 10*time.Second, 100*time.Millisecond)
 func EventuallyWithT(t TestingT, condition func(collect TestingT), waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
-	// TODO
-	is := is.New(t)
-	is.Fail("unsupported function")
-}
-
-func EventuallyWithTf(t TestingT, condition func(collect TestingT), waitFor time.Duration, tick time.Duration, msg string, args ...any) {
-	EventuallyWithT(t, condition, waitFor, tick, append([]any{msg}, args...)...)
-}
-
-func Eventuallyf(t TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msg string, args ...any) {
-	Eventually(t, condition, waitFor, tick, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "EventuallyWithT", condition, waitFor, tick, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	var mu sync.Mutex
+	lastFailure := ""
+	waitFor, capped := capToDeadline(t, span.capWait(waitFor))
+	satisfied := pollCondition(findClock(t), func() bool {
+		failure := (&collectT{TestingT: t, stop: true}).run(condition)
+		mu.Lock()
+		lastFailure = failure
+		mu.Unlock()
+		return failure == ""
+	}, waitFor, tick)
+	if !span.end(a) || satisfied {
+		return
+	}
+	mu.Lock()
+	msg := "condition never satisfied"
+	if capped {
+		msg = fmt.Sprintf("test deadline would be exceeded: condition was not satisfied in %v before the deadline", waitFor)
+	}
+	if lastFailure != "" {
+		msg += ", failures of the last check:\n\t" + strings.ReplaceAll(lastFailure, "\n", "\n\t")
+	}
+	mu.Unlock()
+	a.Fail(msg)
 }
 
+// Exactly asserts that two objects are equal and of exactly the same type,
+// so that int32(5) and int64(5) are not.
 func Exactly(t TestingT, expected any, actual any, msgAndArgs ...any) {
-	is := is.New(t)
-	is.Equal(actual, expected)
-}
-
-func Exactlyf(t TestingT, expected any, actual any, msg string, args ...any) {
-	Exactly(t, expected, actual, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Exactly", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedType, actualType := reflect.TypeOf(expected), reflect.TypeOf(actual)
+	if expectedType != actualType {
+		a.Fail(fmt.Sprintf(
+			"Types expected to match exactly:\nexpected: %s (%v)\nactual  : %s (%v)",
+			formatValue(reflect.ValueOf(expected)), expectedType, formatValue(reflect.ValueOf(actual)), actualType,
+		))
+		return
+	}
+	if !objectsAreEqual(expected, actual) {
+		a.recordValues(expected, actual)
+		a.Fail(withDiff(fmt.Sprintf(
+			"Not equal:\nexpected: %s\nactual  : %s",
+			formatValue(reflect.ValueOf(expected)), formatValue(reflect.ValueOf(actual)),
+		), expected, actual))
+	}
 }
 
 func Fail(t TestingT, failureMessage string, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Fail(failureMessage)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Fail", failureMessage, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(failureMessage)
 }
 
 func FailNow(t TestingT, failureMessage string, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Fail(failureMessage)
-}
-
-func FailNowf(t TestingT, failureMessage string, msg string, args ...any) {
-	FailNow(t, failureMessage, append([]any{msg}, args...)...)
-}
-
-func Failf(t TestingT, failureMessage string, msg string, args ...any) {
-	is := is.New(t)
-	is.AddMsg(msg, args...)
-	is.Fail(failureMessage)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FailNow", failureMessage, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(failureMessage)
 }
 
 func False(t TestingT, value bool, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.False(value)
-}
-
-func Falsef(t TestingT, value bool, msg string, args ...any) {
-	False(t, value, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "False", value, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.False(value)
 }
 
 func FileExists(t TestingT, path string, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "FileExists", path, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			is.Fail(fmt.Sprintf("unable to find file %q", path))
+			a.Fail(fmt.Sprintf("unable to find file %q", path))
 			return
 		}
-		is.Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err))
+		a.Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err))
 		return
 	}
 	if info.IsDir() {
-		is.Fail(fmt.Sprintf("%q is a directory", path))
+		a.Fail(fmt.Sprintf("%q is a directory", path))
 		return
 	}
 }
 
 func Greater[T cmp.Ordered](t TestingT, e1 T, e2 T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Greater", e1, e2, msgAndArgs)()
+	}
 	if e1 > e2 {
 		return
 	}
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Fail(fmt.Sprintf("\"%v\" is not greater than \"%v\"", e1, e2))
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not greater than \"%v\"", e1, e2))
 }
 
 func GreaterOrEqual[T cmp.Ordered](t TestingT, e1 T, e2 T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "GreaterOrEqual", e1, e2, msgAndArgs)()
+	}
 	if e1 >= e2 {
 		return
 	}
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Fail(fmt.Sprintf("\"%v\" is not greater than or equal to \"%v\"", e1, e2))
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not greater than or equal to \"%v\"", e1, e2))
 }
 
-func GreaterOrEqualf[T cmp.Ordered](t TestingT, e1 T, e2 T, msg string, args ...any) {
-	GreaterOrEqual(t, e1, e2, append([]any{msg}, args...)...)
+func Less[T cmp.Ordered](t TestingT, e1 T, e2 T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Less", e1, e2, msgAndArgs)()
+	}
+	if e1 < e2 {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not less than \"%v\"", e1, e2))
 }
 
-func Greaterf[T cmp.Ordered](t TestingT, e1 T, e2 T, msg string, args ...any) {
-	Greater(t, e1, e2, append([]any{msg}, args...)...)
+func LessOrEqual[T cmp.Ordered](t TestingT, e1 T, e2 T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "LessOrEqual", e1, e2, msgAndArgs)()
+	}
+	if e1 <= e2 {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not less than or equal to \"%v\"", e1, e2))
 }
 
-func HTTPBodyContains(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
+// Positive asserts that the specified number is positive (greater than zero).
+func Positive[T core.Number](t TestingT, e T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Positive", e, msgAndArgs)()
+	}
+	var zero T
+	if e > zero {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not positive", e))
 }
 
-func HTTPBodyContainsf(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msg string, args ...any) {
-	HTTPBodyContains(t, handler, method, url, values, str, append([]any{msg}, args...)...)
+// Negative asserts that the specified number is negative (less than zero).
+func Negative[T core.Number](t TestingT, e T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Negative", e, msgAndArgs)()
+	}
+	var zero T
+	if e < zero {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("\"%v\" is not negative", e))
 }
 
-func HTTPBodyNotContains(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
+// HTTPBodyContains asserts that the body of the response of handler to
+// the request contains str. The body is decompressed according to its
+// Content-Encoding, see SetHTTPDecompression.
+func HTTPBodyContains(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPBodyContains", handler, method, url, values, str, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	body, err := httpBody(handler, method, url, values)
+	if !span.end(a) {
+		return
+	}
+	if err != nil {
+		a.Fail(fmt.Sprintf("Failed to get body of %s %s: %v", method, url, err))
+		return
+	}
+	if !strings.Contains(body, fmt.Sprint(str)) {
+		a.Fail(fmt.Sprintf("Expected response body for %q to contain %q but found %q", url+"?"+values.Encode(), str, body))
+	}
 }
 
-func HTTPBodyNotContainsf(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msg string, args ...any) {
-	HTTPBodyNotContains(t, handler, method, url, values, str, append([]any{msg}, args...)...)
+// HTTPBodyNotContains asserts that the body of the response of handler to
+// the request does not contain str, see HTTPBodyContains.
+func HTTPBodyNotContains(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, str any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPBodyNotContains", handler, method, url, values, str, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	body, err := httpBody(handler, method, url, values)
+	if !span.end(a) {
+		return
+	}
+	if err != nil {
+		a.Fail(fmt.Sprintf("Failed to get body of %s %s: %v", method, url, err))
+		return
+	}
+	if strings.Contains(body, fmt.Sprint(str)) {
+		a.Fail(fmt.Sprintf("Expected response body for %q to NOT contain %q but found %q", url+"?"+values.Encode(), str, body))
+	}
 }
 
+// HTTPError asserts that the handler returns an error status code (4xx
+// or 5xx) for the request. values are added to the query of the URL.
 func HTTPError(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
-}
-
-func HTTPErrorf(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msg string, args ...any) {
-	HTTPError(t, handler, method, url, values, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPError", handler, method, url, values, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	code, ok := httpCode(a, handler, method, url, values)
+	if ok && code < http.StatusBadRequest {
+		a.Fail(fmt.Sprintf("Expected HTTP error status code for %q but received %d", url+"?"+values.Encode(), code))
+	}
 }
 
+// HTTPRedirect asserts that the handler returns a redirect status code
+// (3xx) for the request. values are added to the query of the URL.
 func HTTPRedirect(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
-}
-
-func HTTPRedirectf(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msg string, args ...any) {
-	HTTPRedirect(t, handler, method, url, values, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPRedirect", handler, method, url, values, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	code, ok := httpCode(a, handler, method, url, values)
+	if ok && (code < http.StatusMultipleChoices || code >= http.StatusBadRequest) {
+		a.Fail(fmt.Sprintf("Expected HTTP redirect status code for %q but received %d", url+"?"+values.Encode(), code))
+	}
 }
 
+// HTTPStatusCode asserts that the handler returns statuscode for the
+// request. values are added to the query of the URL.
 func HTTPStatusCode(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, statuscode int, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
-}
-
-func HTTPStatusCodef(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, statuscode int, msg string, args ...any) {
-	HTTPStatusCode(t, handler, method, url, values, statuscode, append([]any{msg}, args...)...)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPStatusCode", handler, method, url, values, statuscode, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	code, ok := httpCode(a, handler, method, url, values)
+	if ok && code != statuscode {
+		a.Fail(fmt.Sprintf("Expected HTTP status code %d for %q but received %d", statuscode, url+"?"+values.Encode(), code))
+	}
 }
 
+// HTTPSuccess asserts that the handler returns a success status code
+// (2xx) for the request. values are added to the query of the URL.
 func HTTPSuccess(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "HTTPSuccess", handler, method, url, values, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	code, ok := httpCode(a, handler, method, url, values)
+	if ok && (code < http.StatusOK || code >= http.StatusMultipleChoices) {
+		a.Fail(fmt.Sprintf("Expected HTTP success status code for %q but received %d", url+"?"+values.Encode(), code))
+	}
 }
 
-func HTTPSuccessf(t TestingT, handler http.HandlerFunc, method string, url string, values url.Values, msg string, args ...any) {
-	HTTPSuccess(t, handler, method, url, values, append([]any{msg}, args...)...)
+// Implements asserts that an object is implemented by the specified interface.
+//
+//	require.Implements(t, (*MyInterface)(nil), new(MyObject))
+func Implements(t TestingT, interfaceObject any, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Implements", interfaceObject, object, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	interfaceType := reflect.TypeOf(interfaceObject)
+	if interfaceType == nil || interfaceType.Kind() != reflect.Ptr || interfaceType.Elem().Kind() != reflect.Interface {
+		a.Fail(fmt.Sprintf("expected a pointer to interface like (*MyInterface)(nil), but got %T", interfaceObject))
+		return
+	}
+	interfaceType = interfaceType.Elem()
+	if object == nil {
+		a.Fail(fmt.Sprintf("Cannot check if nil implements %v", interfaceType))
+		return
+	}
+	if !reflect.TypeOf(object).Implements(interfaceType) {
+		a.Fail(fmt.Sprintf("%T must implement %v", object, interfaceType))
+	}
 }
 
-func Implements(t TestingT, interfaceObject any, object any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
+// NotImplements asserts that an object is not implemented by the specified
+// interface.
+//
+//	require.NotImplements(t, (*MyInterface)(nil), new(MyObject))
+func NotImplements(t TestingT, interfaceObject any, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotImplements", interfaceObject, object, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	interfaceType := reflect.TypeOf(interfaceObject)
+	if interfaceType == nil || interfaceType.Kind() != reflect.Ptr || interfaceType.Elem().Kind() != reflect.Interface {
+		a.Fail(fmt.Sprintf("expected a pointer to interface like (*MyInterface)(nil), but got %T", interfaceObject))
+		return
+	}
+	interfaceType = interfaceType.Elem()
+	if object == nil {
+		a.Fail(fmt.Sprintf("Cannot check if nil does not implement %v", interfaceType))
+		return
+	}
+	if reflect.TypeOf(object).Implements(interfaceType) {
+		a.Fail(fmt.Sprintf("%T implements %v", object, interfaceType))
+	}
 }
 
-func Implementsf(t TestingT, interfaceObject any, object any, msg string, args ...any) {
-	Implements(t, interfaceObject, object, append([]any{msg}, args...)...)
+// InDelta asserts that the two numerals are within delta of each other.
+func InDelta(t TestingT, expected any, actual any, delta float64, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "InDelta", expected, actual, delta, msgAndArgs)()
+	}
+	if msg, ok := checkInDelta(expected, actual, delta); !ok {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(msg)
+	}
 }
 
 func NoFileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NoFileExists", path, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 	info, err := os.Lstat(path)
 	if err != nil {
 		return true
@@ -420,32 +777,38 @@ func NoFileExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
 	if info.IsDir() {
 		return true
 	}
-	is.Fail(fmt.Sprintf("file %q exists", path))
+	a.Fail(fmt.Sprintf("file %q exists", path))
 	return false
 }
 
 func DirExists(t TestingT, path string, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "DirExists", path, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			is.Fail(fmt.Sprintf("unable to find file %q", path))
+			a.Fail(fmt.Sprintf("unable to find file %q", path))
 			return
 		}
-		is.Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err))
+		a.Fail(fmt.Sprintf("error when running os.Lstat(%q): %s", path, err))
 		return
 	}
 	if !info.IsDir() {
-		is.Fail(fmt.Sprintf("%q is a file", path))
+		a.Fail(fmt.Sprintf("%q is a file", path))
 	}
 }
 
 // NoDirExists checks whether a directory does not exist in the given path.
 // It fails if the path points to an existing _directory_ only.
 func NoDirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NoDirExists", path, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
 	info, err := os.Lstat(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -456,68 +819,505 @@ func NoDirExists(t TestingT, path string, msgAndArgs ...interface{}) bool {
 	if !info.IsDir() {
 		return true
 	}
-	is.Fail(fmt.Sprintf("directory %q exists", path))
+	a.Fail(fmt.Sprintf("directory %q exists", path))
 	return false
 }
 
-func DirExistsf(t TestingT, path string, msg string, args ...any) {
-	DirExists(t, path, append([]any{msg}, args...)...)
-}
-
+// JSONEq asserts that two JSON strings are equivalent, ignoring formatting
+// and the order of object keys. The failure message shows the differences
+// of the JSON values, pretty-printed with sorted keys.
 func JSONEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
-	return false
-}
-
-func YAMLEq(t TestingT, expected string, actual string, msgAndArgs ...interface{}) bool {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	// TODO
-	is.Fail("unsupported function")
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "JSONEq", expected, actual, msgAndArgs)()
+	}
+	return JSONEqWith(t, expected, actual, JSONEqOptions{}, msgAndArgs...)
+}
+
+// JSONEqOptions configures JSONEqWith.
+type JSONEqOptions struct {
+	// Patch shows the differences as a JSON Patch (RFC 6902) transforming
+	// expected into actual, with one operation per line, instead of a
+	// line diff, for tooling that updates fixtures:
+	//
+	//	[
+	//	  {"op":"replace","path":"/items/0/name","value":"bob"},
+	//	  {"op":"remove","path":"/id"}
+	//	]
+	Patch bool
+}
+
+// JSONEqWith is like JSONEq, configured by opts.
+func JSONEqWith(t TestingT, expected string, actual string, opts JSONEqOptions, msgAndArgs ...any) bool {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "JSONEqWith", expected, actual, opts, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	var expectedValue, actualValue any
+	if err := json.Unmarshal([]byte(expected), &expectedValue); err != nil {
+		a.Fail(fmt.Sprintf("Expected value ('%s') is not valid JSON: %v", expected, err))
+		return false
+	}
+	if err := json.Unmarshal([]byte(actual), &actualValue); err != nil {
+		a.Fail(fmt.Sprintf("Input ('%s') needs to be valid JSON: %v", actual, err))
+		return false
+	}
+	if reflect.DeepEqual(expectedValue, actualValue) {
+		return true
+	}
+	if opts.Patch {
+		a.Fail("JSON not equal, patch from expected to actual:\n" + formatJSONPatch(jsonPatch("", expectedValue, actualValue)))
+		return false
+	}
+	a.Fail("JSON not equal:\n" + strings.TrimSuffix(diff.Unified(
+		"expected", "actual",
+		string(normalizeJSON([]byte(expected))), string(normalizeJSON([]byte(actual))),
+	), "\n"))
 	return false
 }
 
 func IsType(t TestingT, expectedType any, object any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.IsType(expectedType.(reflect.Type), object)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsType", expectedType, object, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.IsType(expectedType.(reflect.Type), object)
 }
 
+// Len asserts that the array, slice, map or iterator object has the given
+// number of elements, or that its Len() int method (such as the method of
+// bytes.Buffer, strings.Builder or a Container) returns length.
 func Len(t TestingT, object any, length int, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Len(object, length)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Len", object, length, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Len(object, length)
 }
 
 func Nil(t TestingT, object any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.Nil(object)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Nil", object, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Nil(object)
 }
 
 func NoError(t TestingT, err error, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.NotErr(err)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NoError", err, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.NotErr(err)
 }
 
 func NotNil(t TestingT, object any, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.NotNil(object)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotNil", object, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.NotNil(object)
+}
+
+// NilPtr asserts that ptr is a nil pointer. Unlike Nil, the argument is
+// not converted to an interface, so the pointer type is known even for
+// a nil pointer, and is shown in the failure message.
+func NilPtr[T any](t TestingT, ptr *T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NilPtr", ptr, msgAndArgs)()
+	}
+	if ptr == nil {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.failf("expected nil '%T', but got pointer to: %v", ptr, *ptr)
+}
+
+// NotNilPtr asserts that ptr is not a nil pointer.
+func NotNilPtr[T any](t TestingT, ptr *T, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotNilPtr", ptr, msgAndArgs)()
+	}
+	if ptr != nil {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.failf("expected non-nil '%T', but got nil", ptr)
+}
+
+// NotContains asserts that s does not contain the element contains, see
+// Contains.
+func NotContains(t TestingT, s any, contains any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotContains", s, contains, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	ok, found := containsElement(s, contains)
+	if !ok {
+		a.Fail(fmt.Sprintf("unexpected argument types %T and %T", s, contains))
+		return
+	}
+	if found {
+		a.Fail(fmt.Sprintf("%#v should not contain %#v", s, contains))
+	}
+}
+
+// NotEmpty asserts that the object is not empty, see Empty.
+func NotEmpty(t TestingT, object any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotEmpty", object, msgAndArgs)()
+	}
+	NotEmptyWith(t, object, EmptyOptions{}, msgAndArgs...)
+}
+
+// NotEmptyWith is like NotEmpty, configured by opts.
+func NotEmptyWith(t TestingT, object any, opts EmptyOptions, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotEmptyWith", object, opts, msgAndArgs)()
+	}
+	if isEmptyWith(object, opts) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("Should NOT be empty, but was %s", describeValue(object)))
+	}
+}
+
+func NotEqual(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotEqual", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if isEqualConverted(actual, expected) {
+		a.Fail(fmt.Sprintf("Should not be: %#v", actual))
+	}
+}
+
+// NotEqualValues asserts that two objects are not equal, even after
+// conversion to the type of the other, see EqualValues.
+func NotEqualValues(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotEqualValues", expected, actual, msgAndArgs)()
+	}
+	if !objectsAreEqualValues(expected, actual) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("Should not be: %#v", actual))
+}
+
+// NotErrorIs asserts that none of the errors in err's chain matches target.
+// This is a wrapper for errors.Is.
+func NotErrorIs(t TestingT, err error, target error, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotErrorIs", err, target, msgAndArgs)()
+	}
+	if !errors.Is(err, target) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf(
+		"Target error should not be in err chain:\nfound: %q\nin chain: %s",
+		target.Error(), buildErrorChainString(err),
+	))
+}
+
+func NotPanics(t TestingT, f PanicTestFunc, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotPanics", f, msgAndArgs)()
+	}
+	panicked, panicValue, panicStack := didPanic(f)
+	if !panicked {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("func %#v should not panic\n\tPanic value:\t%v\n\tPanic stack:\t%s", f, panicValue, panicStack))
+}
+
+func NotRegexp(t TestingT, rx any, str any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotRegexp", rx, str, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	match, err := matchRegexp(rx, str)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	if match {
+		a.Fail(fmt.Sprintf("Expect \"%v\" to NOT match \"%v\"", str, rx))
+	}
+}
+
+// NotSame asserts that two pointers do not reference the same object.
+func NotSame(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotSame", expected, actual, msgAndArgs)()
+	}
+	if !samePointers(expected, actual) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("Expected and actual point to the same object: %p %#v", expected, expected))
+}
+
+// NotSubset asserts that subset (an array or slice, or a map) is not a
+// subset of list (an array, slice or map), see Subset.
+func NotSubset(t TestingT, list any, subset any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotSubset", list, subset, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	if subset == nil {
+		a.Fail("nil is the empty set which is a subset of every set")
+		return
+	}
+	isSubset, err := subsetOf(list, subset)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	if isSubset {
+		a.Fail(fmt.Sprintf("%#v is a subset of %#v", subset, list))
+	}
+}
+
+func NotZero(t TestingT, i any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "NotZero", i, msgAndArgs)()
+	}
+	if !isZero(i) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("Should not be zero, but was %v", i))
+}
+
+// Never asserts that the given condition doesn't get met in waitFor time,
+// periodically checking the target function each tick.
+// Like Eventually, waitFor is capped at the test deadline, but since the
+// condition can not be checked for the whole waitFor, the test fails.
+func Never(t TestingT, condition func() bool, waitFor time.Duration, tick time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Never", condition, waitFor, tick, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	span, ok := startBudget(a)
+	if !ok {
+		return
+	}
+	waitFor, capped := capToDeadline(t, span.capWait(waitFor))
+	satisfied := pollCondition(findClock(t), condition, waitFor, tick)
+	if !span.end(a) {
+		return
+	}
+	if satisfied {
+		a.Fail("condition satisfied")
+		return
+	}
+	if capped {
+		a.Fail(fmt.Sprintf("test deadline would be exceeded: condition could only be checked for %v before the deadline", waitFor))
+	}
 }
 
 func Panics(t TestingT, f PanicTestFunc, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.ShouldPanic(f)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Panics", f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.ShouldPanic(f)
+}
+
+// PanicsWithError asserts that the code inside the specified PanicTestFunc
+// panics, and that the recovered panic value is an error that satisfies the
+// EqualError comparison.
+func PanicsWithError(t TestingT, errString string, f PanicTestFunc, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "PanicsWithError", errString, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	panicked, panicValue, panicStack := didPanic(f)
+	if !panicked {
+		a.Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue))
+		return
+	}
+	panicErr, ok := panicValue.(error)
+	if !ok || panicErr.Error() != errString {
+		a.Fail(fmt.Sprintf("func %#v should panic with error message:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, errString, panicValue, panicStack))
+	}
+}
+
+// PanicsWithValue asserts that the code inside the specified PanicTestFunc
+// panics, and that the recovered panic value equals the expected panic value.
+func PanicsWithValue(t TestingT, expected any, f PanicTestFunc, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "PanicsWithValue", expected, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	panicked, panicValue, panicStack := didPanic(f)
+	if !panicked {
+		a.Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue))
+		return
+	}
+	if panicValue != expected {
+		a.Fail(fmt.Sprintf("func %#v should panic with value:\t%#v\n\tPanic value:\t%#v\n\tPanic stack:\t%s", f, expected, panicValue, panicStack))
+	}
+}
+
+// PanicsWithMatch asserts that the code inside the specified PanicTestFunc
+// panics, and that the recovered panic value, formatted with %v (so the
+// message of an error, including runtime errors), matches the regexp
+// pattern (a *regexp.Regexp or a string).
+func PanicsWithMatch(t TestingT, pattern any, f PanicTestFunc, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "PanicsWithMatch", pattern, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	panicked, panicValue, panicStack := didPanic(f)
+	if !panicked {
+		a.Fail(fmt.Sprintf("func %#v should panic\n\tPanic value:\t%#v", f, panicValue))
+		return
+	}
+	match, err := matchRegexp(pattern, panicValue)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	if !match {
+		a.Fail(fmt.Sprintf("func %#v should panic with value matching:\t%v\n\tPanic value:\t%v\n\tPanic stack:\t%s", f, pattern, panicValue, panicStack))
+	}
+}
+
+// Regexp asserts that a specified regexp (a *regexp.Regexp or a string)
+// matches a string (or the value formatted with %v).
+func Regexp(t TestingT, rx any, str any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Regexp", rx, str, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	match, err := matchRegexp(rx, str)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	if !match {
+		a.Fail(fmt.Sprintf("Expect \"%v\" to match \"%v\"", str, rx))
+	}
+}
+
+// Same asserts that two pointers reference the same object.
+func Same(t TestingT, expected any, actual any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Same", expected, actual, msgAndArgs)()
+	}
+	if samePointers(expected, actual) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf(
+		"Not same:\nexpected: %p %#v\nactual  : %p %#v",
+		expected, expected, actual, actual,
+	))
+}
+
+// Subset asserts that list (an array, slice or map) contains all the
+// elements of subset (an array or slice). For a map list, the elements of
+// subset are its keys, and a map subset must have the same entries in
+// list:
+//
+//	require.Subset(t, []int{1, 2, 3}, []int{1, 3})
+//	require.Subset(t, map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1})
+func Subset(t TestingT, list any, subset any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Subset", list, subset, msgAndArgs)()
+	}
+	if subset == nil {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	isSubset, err := subsetOf(list, subset)
+	if err != nil {
+		a.Fail(err.Error())
+		return
+	}
+	if !isSubset {
+		a.Fail(fmt.Sprintf("%#v does not contain %#v", list, subset))
+	}
 }
 
 func True(t TestingT, value bool, msgAndArgs ...any) {
-	is := is.New(t)
-	addMsg(is, msgAndArgs)
-	is.True(value)
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "True", value, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.True(value)
+}
+
+// WithinDuration asserts that the two times are within duration delta of each other.
+func WithinDuration(t TestingT, expected time.Time, actual time.Time, delta time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "WithinDuration", expected, actual, delta, msgAndArgs)()
+	}
+	dt := expected.Sub(actual)
+	if dt >= -delta && dt <= delta {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("Max difference between %v and %v allowed is %v, but difference was %v", expected, actual, delta, dt))
+}
+
+// WithinRange asserts that a time is within a time range (inclusive).
+func WithinRange(t TestingT, actual time.Time, start time.Time, end time.Time, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "WithinRange", actual, start, end, msgAndArgs)()
+	}
+	if end.Before(start) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail("Start should be before end")
+		return
+	}
+	if actual.Before(start) || actual.After(end) {
+		a := newAsserter(t, msgAndArgs)
+		a.Fail(fmt.Sprintf("Time %v expected to be in time range %v to %v", actual, start, end))
+	}
+}
+
+func Zero(t TestingT, i any, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "Zero", i, msgAndArgs)()
+	}
+	if isZero(i) {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("Should be zero, but was %v", i))
 }
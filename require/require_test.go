@@ -0,0 +1,199 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEqualValues(t *testing.T) {
+	tests := []struct {
+		name             string
+		expected, actual any
+		failed           bool
+	}{
+		{"same type", 5, 5, false},
+		{"converted integers", int32(5), int64(5), false},
+		{"signed and unsigned", 10, uint(10), false},
+		{"converted strings", "a", []byte("a"), false},
+		{"different integers", int32(5), int64(6), true},
+		{"not convertible", 5, "5", true},
+		{"nil and zero", nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ft := newFakeT(t)
+			EqualValues(ft, tt.expected, tt.actual)
+			expectFailed(t, ft, tt.failed)
+		})
+	}
+}
+
+func TestEqualValuesf(t *testing.T) {
+	ft := newFakeT(t)
+	EqualValuesf(ft, int32(5), int64(5), "user %d", 1)
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EqualValuesf(ft, int32(5), int64(6), "user %d", 1)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "user 1")
+}
+
+func TestEqualT(t *testing.T) {
+	type box struct{ V any }
+
+	ft := newFakeT(t)
+	EqualT(ft, 3, 3)
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EqualT(ft, "a", "b")
+	expectFailed(t, ft, true)
+
+	// == panics on the slices in the interface fields
+	ft = newFakeT(t)
+	EqualT(ft, box{[]int{1, 2}}, box{[]int{1, 2}})
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EqualT(ft, box{[]int{1, 2}}, box{[]int{2, 1}})
+	expectFailed(t, ft, true)
+}
+
+func TestEqualExportedValues(t *testing.T) {
+	type user struct {
+		Name   string
+		Tags   []string
+		secret string
+	}
+
+	ft := newFakeT(t)
+	EqualExportedValues(ft, user{Name: "alice", secret: "a"}, user{Name: "alice", secret: "b"})
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EqualExportedValues(ft, user{Name: "alice"}, user{Name: "bob"})
+	expectFailed(t, ft, true)
+
+	// like ObjectsAreEqual, nil and empty slices differ
+	ft = newFakeT(t)
+	EqualExportedValues(ft, user{Tags: nil}, user{Tags: []string{}})
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "[]string(nil)")
+}
+
+func TestEventually(t *testing.T) {
+	var calls atomic.Int32
+	ft := newFakeT(t)
+	Eventually(ft, func() bool {
+		return calls.Add(1) == 3
+	}, time.Second, time.Millisecond)
+	expectFailed(t, ft, false)
+	if n := calls.Load(); n != 3 {
+		t.Errorf("expected the condition to be checked 3 times, got %d", n)
+	}
+
+	ft = newFakeT(t)
+	Eventually(ft, func() bool { return false }, 50*time.Millisecond, time.Millisecond)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "condition never satisfied")
+}
+
+func TestNever(t *testing.T) {
+	ft := newFakeT(t)
+	Never(ft, func() bool { return false }, 50*time.Millisecond, time.Millisecond)
+	expectFailed(t, ft, false)
+
+	var calls atomic.Int32
+	ft = newFakeT(t)
+	Never(ft, func() bool {
+		return calls.Add(1) == 3
+	}, time.Second, time.Millisecond)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "condition satisfied")
+}
+
+func TestEventuallyWithT(t *testing.T) {
+	var calls atomic.Int32
+	ft := newFakeT(t)
+	EventuallyWithT(ft, func(c TestingT) {
+		Equal(c, int32(3), calls.Add(1))
+	}, time.Second, time.Millisecond)
+	expectFailed(t, ft, false)
+
+	ft = newFakeT(t)
+	EventuallyWithT(ft, func(c TestingT) {
+		Equal(c, "ready", "starting")
+	}, 50*time.Millisecond, time.Millisecond)
+	expectFailed(t, ft, true)
+	expectMessage(t, ft, "failures of the last check", "starting")
+}
+
+func TestSubset(t *testing.T) {
+	tests := []struct {
+		list, subset any
+		isSubset     bool
+	}{
+		{[]int{1, 2, 3}, []int{1, 3}, true},
+		{[]int{1, 2, 3}, []int{1, 4}, false},
+		{[]int{1, 2}, []int{}, true},
+		{map[string]int{"a": 1, "b": 2}, []string{"a"}, true},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1}, true},
+		{map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2}, false},
+	}
+	for _, tt := range tests {
+		ft := newFakeT(t)
+		Subset(ft, tt.list, tt.subset)
+		if ft.Failed() == tt.isSubset {
+			t.Errorf("Subset(%#v, %#v) failed = %v", tt.list, tt.subset, ft.Failed())
+		}
+		ft = newFakeT(t)
+		NotSubset(ft, tt.list, tt.subset)
+		if ft.Failed() != tt.isSubset {
+			t.Errorf("NotSubset(%#v, %#v) failed = %v", tt.list, tt.subset, ft.Failed())
+		}
+	}
+
+	ft := newFakeT(t)
+	Subset(ft, "abc", []string{"a"})
+	expectFailed(t, ft, true)
+}
+
+func TestFailureCode(t *testing.T) {
+	ft := newFakeT(t)
+	Equalf(ft, 1, 2, "msg")
+	expectMessage(t, ft, "Code: DMND-EQ001 (https://pkg.go.dev/github.com/ilius/demand/require#Equal)")
+
+	// Subject.Equals has no code, and must not report the code of Equal.
+	ft = newFakeT(t)
+	That(ft, 1).Equals(2)
+	expectFailed(t, ft, true)
+	if msg := ft.message(); strings.Contains(msg, "Code:") {
+		t.Errorf("expected no failure code, got:\n%s", msg)
+	}
+}
@@ -0,0 +1,198 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/metrics"
+	"strings"
+	"time"
+)
+
+// resourceSampleInterval is the interval of sampling runtime metrics while
+// the workload of WithinResourceBudget runs.
+const resourceSampleInterval = time.Millisecond
+
+// Budget is the resource budget of a workload, see WithinResourceBudget.
+// It is unrelated to the time budget of WithBudget. A zero field means no
+// limit on that dimension.
+type Budget struct {
+	// MaxGoroutines is the maximum number of goroutines that may be running
+	// at the same time, in addition to those running before the workload.
+	MaxGoroutines int
+
+	// MaxHeapBytes is the maximum growth of the bytes of heap objects
+	// (including garbage not yet collected) over the heap before the
+	// workload, which is measured after a garbage collection.
+	MaxHeapBytes uint64
+
+	// MaxGCPauses is the maximum number of stop-the-world pauses of the
+	// garbage collector during the workload.
+	MaxGCPauses uint64
+}
+
+const (
+	metricGoroutines = "/sched/goroutines:goroutines"
+	metricHeapBytes  = "/memory/classes/heap/objects:bytes"
+	metricGCPauses   = "/sched/pauses/total/gc:seconds"
+	// metricGCPausesOld is the deprecated name of metricGCPauses, for
+	// Go versions before 1.22.
+	metricGCPausesOld = "/gc/pauses:seconds"
+)
+
+// newResourceSamples returns the samples of the runtime metrics of Budget,
+// for readResourceUsage.
+func newResourceSamples() []metrics.Sample {
+	return []metrics.Sample{
+		{Name: metricGoroutines},
+		{Name: metricHeapBytes},
+		{Name: metricGCPauses},
+		{Name: metricGCPausesOld},
+	}
+}
+
+// resourceUsage is a sample of the runtime metrics of Budget.
+type resourceUsage struct {
+	goroutines int
+	heapBytes  uint64
+	gcPauses   uint64
+	// gcPausesOK is false if the runtime has no metric of GC pauses.
+	gcPausesOK bool
+}
+
+// readResourceUsage samples the runtime metrics of Budget, given samples
+// returned by newResourceSamples.
+func readResourceUsage(samples []metrics.Sample) resourceUsage {
+	metrics.Read(samples)
+	var usage resourceUsage
+	if samples[0].Value.Kind() == metrics.KindUint64 {
+		usage.goroutines = int(samples[0].Value.Uint64())
+	} else {
+		usage.goroutines = runtime.NumGoroutine()
+	}
+	if samples[1].Value.Kind() == metrics.KindUint64 {
+		usage.heapBytes = samples[1].Value.Uint64()
+	}
+	for _, sample := range samples[2:] {
+		if sample.Value.Kind() != metrics.KindFloat64Histogram {
+			continue
+		}
+		for _, count := range sample.Value.Float64Histogram().Counts {
+			usage.gcPauses += count
+		}
+		usage.gcPausesOK = true
+		break
+	}
+	return usage
+}
+
+// WithinResourceBudget asserts that running f stays within the resource
+// budget, for guarding the steady-state behavior of services in
+// long-running integration tests:
+//
+//	require.WithinResourceBudget(t, require.Budget{
+//		MaxGoroutines: 50,
+//		MaxHeapBytes:  64 << 20,
+//	}, func() {
+//		runLoad(client, 10_000)
+//	})
+//
+// The runtime metrics are sampled every millisecond while f runs, and the
+// failure message shows the exceeded dimensions, with their peak usage.
+// Since the metrics are of the whole process, tests running in parallel
+// count as well.
+func WithinResourceBudget(t TestingT, budget Budget, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "WithinResourceBudget", budget, f, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	runtime.GC()
+	samples := newResourceSamples()
+	before := readResourceUsage(samples)
+	if budget.MaxGCPauses > 0 && !before.gcPausesOK {
+		a.failf("cannot check the budget of GC pauses: the runtime has neither the %s nor the %s metric", metricGCPauses, metricGCPausesOld)
+		return
+	}
+	peak := before
+
+	stop := make(chan struct{})
+	sampled := make(chan struct{})
+	go func() {
+		defer close(sampled)
+		samples := newResourceSamples()
+		ticker := time.NewTicker(resourceSampleInterval)
+		defer ticker.Stop()
+		for stopped := false; !stopped; {
+			select {
+			case <-stop:
+				// a last sample, after f returned
+				stopped = true
+			case <-ticker.C:
+			}
+			usage := readResourceUsage(samples)
+			// this goroutine is not part of the workload
+			usage.goroutines--
+			if usage.goroutines > peak.goroutines {
+				peak.goroutines = usage.goroutines
+			}
+			if usage.heapBytes > peak.heapBytes {
+				peak.heapBytes = usage.heapBytes
+			}
+		}
+	}()
+	func() {
+		defer func() {
+			close(stop)
+			<-sampled
+		}()
+		f()
+	}()
+	after := readResourceUsage(samples)
+
+	var exceeded []string
+	if goroutines := peak.goroutines - before.goroutines; budget.MaxGoroutines > 0 && goroutines > budget.MaxGoroutines {
+		exceeded = append(exceeded, fmt.Sprintf(
+			"goroutines: %d in addition to the %d before, budget %d",
+			goroutines, before.goroutines, budget.MaxGoroutines,
+		))
+	}
+	if heap := peak.heapBytes - before.heapBytes; budget.MaxHeapBytes > 0 && heap > budget.MaxHeapBytes {
+		exceeded = append(exceeded, fmt.Sprintf(
+			"heap: grew by %d bytes (from %d to %d), budget %d",
+			heap, before.heapBytes, peak.heapBytes, budget.MaxHeapBytes,
+		))
+	}
+	if pauses := after.gcPauses - before.gcPauses; budget.MaxGCPauses > 0 && pauses > budget.MaxGCPauses {
+		exceeded = append(exceeded, fmt.Sprintf(
+			"GC pauses: %d, budget %d",
+			pauses, budget.MaxGCPauses,
+		))
+	}
+	if len(exceeded) == 0 {
+		return
+	}
+	a.Fail("resource budget exceeded:\n\t" + strings.Join(exceeded, "\n\t"))
+}
@@ -0,0 +1,45 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import "testing"
+
+func TestReadResourceUsageGCPauses(t *testing.T) {
+	if usage := readResourceUsage(newResourceSamples()); !usage.gcPausesOK {
+		t.Error("expected the metric of GC pauses to be read")
+	}
+
+	samples := newResourceSamples()
+	samples[2].Name = "/unknown:seconds"
+	if usage := readResourceUsage(samples); !usage.gcPausesOK {
+		t.Errorf("expected a fallback to %s", metricGCPausesOld)
+	}
+
+	samples = newResourceSamples()
+	samples[2].Name = "/unknown:seconds"
+	samples[3].Name = "/unknown:seconds"
+	if usage := readResourceUsage(samples); usage.gcPausesOK {
+		t.Error("expected no metric of GC pauses")
+	}
+}
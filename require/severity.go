@@ -0,0 +1,102 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"os"
+)
+
+// ciEnvVars are environment variables set by CI systems, see isCI.
+var ciEnvVars = []string{
+	"CI",               // GitHub Actions, GitLab CI, CircleCI, Travis CI, Buildkite and others
+	"JENKINS_URL",      // Jenkins
+	"TF_BUILD",         // Azure Pipelines
+	"TEAMCITY_VERSION", // TeamCity
+}
+
+// isCI reports if the test runs on CI, detected by the environment
+// variables of common CI systems. CI=false is not considered as CI.
+func isCI() bool {
+	for _, name := range ciEnvVars {
+		if value := os.Getenv(name); value != "" && value != "false" {
+			return true
+		}
+	}
+	return false
+}
+
+// warnT is a TestingT on which failures are logged as warnings instead of
+// failing the test, see WarnUnlessCI.
+type warnT struct {
+	TestingT
+}
+
+const warningPrefix = "warning (fails on CI): "
+
+func (w warnT) Error(args ...any) {
+	w.TestingT.Helper()
+	w.TestingT.Log(warningPrefix + fmt.Sprint(args...))
+}
+
+func (w warnT) Errorf(format string, args ...any) {
+	w.TestingT.Helper()
+	w.TestingT.Log(warningPrefix + fmt.Sprintf(format, args...))
+}
+
+func (w warnT) Fatal(args ...any) {
+	w.TestingT.Helper()
+	w.TestingT.Log(warningPrefix + fmt.Sprint(args...))
+}
+
+func (w warnT) Fatalf(format string, args ...any) {
+	w.TestingT.Helper()
+	w.TestingT.Log(warningPrefix + fmt.Sprintf(format, args...))
+}
+
+func (w warnT) Fail() {}
+
+func (w warnT) FailNow() {}
+
+// WarnUnlessCI returns t on CI, and otherwise a TestingT on which the
+// failures of assertions are logged as warnings, without failing the test,
+// for tightening the checks of a legacy suite without blocking local runs:
+//
+//	require.Equal(require.WarnUnlessCI(t), expectedCount, len(rows))
+//
+// CI is detected by the CI environment variable (set by most CI systems),
+// and the variables of Jenkins, Azure Pipelines and TeamCity. Warnings are
+// only shown by go test -v, or along with other failures of the test.
+func WarnUnlessCI(t TestingT) TestingT {
+	if isCI() {
+		return t
+	}
+	return warnT{t}
+}
+
+// WarnUnlessCI returns Assertions whose failures are warnings unless run
+// on CI, see WarnUnlessCI.
+func (a *Assertions) WarnUnlessCI() *Assertions {
+	return &Assertions{t: WarnUnlessCI(a.t)}
+}
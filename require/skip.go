@@ -0,0 +1,95 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// SkipIfShort skips the test in short mode (go test -short), for slow
+// tests such as integration tests.
+func SkipIfShort(t TestingT, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "SkipIfShort", msgAndArgs)()
+	}
+	if !testing.Short() {
+		return
+	}
+	t.Skip(messageFromMsgAndArgs("skipping in short mode", msgAndArgs))
+}
+
+// SkipUnlessEnv skips the test if the environment variable name is not
+// set or empty, and returns its value otherwise, for tests that need an
+// external resource:
+//
+//	dsn := require.SkipUnlessEnv(t, "TEST_DATABASE_URL")
+func SkipUnlessEnv(t TestingT, name string, msgAndArgs ...any) string {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "SkipUnlessEnv", name, msgAndArgs)()
+	}
+	value := os.Getenv(name)
+	if value == "" {
+		t.Skip(messageFromMsgAndArgs("skipping: environment variable "+name+" is not set", msgAndArgs))
+	}
+	return value
+}
+
+// SkipOnOS skips the test on the given platform, which is either an
+// operating system like "windows", or an operating system and
+// architecture like "linux/arm64" (see runtime.GOOS and runtime.GOARCH).
+func SkipOnOS(t TestingT, platform string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "SkipOnOS", platform, msgAndArgs)()
+	}
+	goos, goarch, hasArch := strings.Cut(platform, "/")
+	if goos != runtime.GOOS || (hasArch && goarch != runtime.GOARCH) {
+		return
+	}
+	t.Skip(messageFromMsgAndArgs("skipping on "+platform, msgAndArgs))
+}
+
+// SkipWithoutBinary skips the test if the executable name is not found in
+// the directories of the PATH environment variable (see exec.LookPath),
+// and returns its path otherwise:
+//
+//	docker := require.SkipWithoutBinary(t, "docker")
+//	require.CmdSucceeds(t, exec.Command(docker, "info"))
+func SkipWithoutBinary(t TestingT, name string, msgAndArgs ...any) string {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "SkipWithoutBinary", name, msgAndArgs)()
+	}
+	path, err := exec.LookPath(name)
+	if err != nil {
+		t.Skip(messageFromMsgAndArgs("skipping: executable "+name+" is not found in PATH", msgAndArgs))
+	}
+	return path
+}
@@ -0,0 +1,103 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+// softT is a TestingT on which fatal failures are reported as non-fatal
+// errors, see Soft.
+type softT struct {
+	TestingT
+}
+
+func (s softT) Fatal(args ...any) {
+	s.TestingT.Helper()
+	s.TestingT.Error(args...)
+}
+
+func (s softT) Fatalf(format string, args ...any) {
+	s.TestingT.Helper()
+	s.TestingT.Errorf(format, args...)
+}
+
+func (s softT) FailNow() {
+	s.TestingT.Fail()
+}
+
+// strictT is a TestingT on which non-fatal errors are reported as fatal
+// failures, see Strict.
+type strictT struct {
+	TestingT
+}
+
+func (s strictT) Error(args ...any) {
+	s.TestingT.Helper()
+	s.TestingT.Fatal(args...)
+}
+
+func (s strictT) Errorf(format string, args ...any) {
+	s.TestingT.Helper()
+	s.TestingT.Fatalf(format, args...)
+}
+
+func (s strictT) Fail() {
+	s.TestingT.FailNow()
+}
+
+// Soft returns a TestingT on which the failure of an assertion is reported
+// without stopping the test, so that a single require call can be made
+// non-fatal:
+//
+//	require.Equal(require.Soft(t), expected.Name, user.Name)
+//	require.Equal(t, expected.ID, user.ID) // still stops the test
+//
+// The assertion returns after reporting its failure, like assertions of
+// testify/assert.
+func Soft(t TestingT) TestingT {
+	switch t := t.(type) {
+	case softT:
+		return t
+	case strictT:
+		return softT{t.TestingT}
+	}
+	return softT{t}
+}
+
+// Strict returns a TestingT on which non-fatal errors (Error, Errorf and
+// Fail) stop the test, so that a single call of a non-fatal assertion,
+// such as those of testify/assert or mock, can be made fatal:
+//
+//	assert.Equal(require.Strict(t), expected, actual)
+func Strict(t TestingT) TestingT {
+	switch t := t.(type) {
+	case strictT:
+		return t
+	case softT:
+		return strictT{t.TestingT}
+	}
+	return strictT{t}
+}
+
+// Soft returns Assertions whose failures do not stop the test, see Soft.
+func (a *Assertions) Soft() *Assertions {
+	return &Assertions{t: Soft(a.t)}
+}
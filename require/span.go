@@ -0,0 +1,146 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"sync"
+)
+
+// SpanRecorder records events on a trace span, such as an OpenTelemetry
+// span, see WithSpan.
+type SpanRecorder interface {
+	AddEvent(name string, attributes map[string]string)
+}
+
+// spanT is a TestingT recording failures as span events, see WithSpan.
+type spanT struct {
+	TestingT
+	span SpanRecorder
+
+	mu        sync.Mutex
+	hasValues bool
+	expected  any
+	actual    any
+}
+
+// setValues sets the expected and actual values of the next failure.
+func (s *spanT) setValues(expected any, actual any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hasValues = true
+	s.expected = expected
+	s.actual = actual
+}
+
+// record adds the "assertion.failed" event for a failure message.
+func (s *spanT) record(msg string) {
+	name, code, _ := failingAssertion(2)
+	attributes := map[string]string{
+		"test.name":         s.TestingT.Name(),
+		"assertion.message": msg,
+	}
+	if name != "" {
+		attributes["assertion.name"] = name
+	}
+	if code != "" {
+		attributes["assertion.code"] = code
+	}
+	s.mu.Lock()
+	if s.hasValues {
+		attributes["assertion.expected"] = fmt.Sprintf("%#v", s.expected)
+		attributes["assertion.actual"] = fmt.Sprintf("%#v", s.actual)
+		s.hasValues = false
+	}
+	s.mu.Unlock()
+	s.span.AddEvent("assertion.failed", attributes)
+}
+
+func (s *spanT) Error(args ...any) {
+	s.TestingT.Helper()
+	s.record(fmt.Sprint(args...))
+	s.TestingT.Error(args...)
+}
+
+func (s *spanT) Errorf(format string, args ...any) {
+	s.TestingT.Helper()
+	s.record(fmt.Sprintf(format, args...))
+	s.TestingT.Errorf(format, args...)
+}
+
+func (s *spanT) Fatal(args ...any) {
+	s.TestingT.Helper()
+	s.record(fmt.Sprint(args...))
+	s.TestingT.Fatal(args...)
+}
+
+func (s *spanT) Fatalf(format string, args ...any) {
+	s.TestingT.Helper()
+	s.record(fmt.Sprintf(format, args...))
+	s.TestingT.Fatalf(format, args...)
+}
+
+// WithSpan returns Assertions that record their failures as
+// "assertion.failed" events on span, for correlating the failures of
+// distributed integration tests with the traces of the backends. The
+// attributes of the events are:
+//   - test.name: the name of the test
+//   - assertion.name: the name of the assertion, such as "require.Equal"
+//   - assertion.code: the failure code of the assertion, see FailureCodes
+//   - assertion.message: the failure message
+//   - assertion.expected and assertion.actual: the values of failed
+//     equality assertions, formatted with %#v
+//
+// The package does not depend on OpenTelemetry, so a trace.Span is given
+// with an adapter:
+//
+//	type otelSpan struct{ span trace.Span }
+//
+//	func (s otelSpan) AddEvent(name string, attributes map[string]string) {
+//		var attrs []attribute.KeyValue
+//		for key, value := range attributes {
+//			attrs = append(attrs, attribute.String(key, value))
+//		}
+//		s.span.AddEvent(name, trace.WithAttributes(attrs...))
+//	}
+//
+//	ctx, span := tracer.Start(ctx, t.Name())
+//	defer span.End()
+//	r := require.WithSpan(t, otelSpan{span})
+//
+// The span is kept by the Assertions derived from the returned ones, such
+// as by Soft or With.
+func WithSpan(t TestingT, span SpanRecorder) *Assertions {
+	return &Assertions{t: &spanT{TestingT: t, span: span}}
+}
+
+// findSpan returns the spanT that t is or wraps, or nil.
+func findSpan(t TestingT) *spanT {
+	for ; t != nil; t = innerT(t) {
+		if s, ok := t.(*spanT); ok {
+			return s
+		}
+	}
+	return nil
+}
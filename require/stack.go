@@ -0,0 +1,213 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+)
+
+// StackMode selects the frames shown in the stack traces of failure
+// messages, see SetStackMode.
+type StackMode int
+
+const (
+	// StackUser shows only the frames of user code, hiding the frames of
+	// demand packages, the runtime and the testing package. Failures of
+	// assertions are reported at the line of the test calling the
+	// assertion, without a stack trace.
+	StackUser StackMode = iota
+	// StackUserAndAssertion is like StackUser, but also shows the frame of
+	// the assertion function called by user code, and adds the stack trace
+	// to the failures of assertions.
+	StackUserAndAssertion
+	// StackFull shows all frames, and adds the stack trace to the failures
+	// of assertions, for debugging demand itself.
+	StackFull
+)
+
+// libraryPath is the import path prefix of demand packages, whose frames
+// are hidden in stack traces.
+const libraryPath = "github.com/ilius/demand/"
+
+var stackMode = StackUser
+
+// SetStackMode sets the frames shown in stack traces of failure messages,
+// such as the stack of a panic reported by PanicsWithValue (StackUser by
+// default).
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetStackMode(mode StackMode) {
+	stackMode = mode
+}
+
+// isLibraryFrame reports if frame is a function of a demand package
+// (excluding tests).
+func isLibraryFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, libraryPath) && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// isSystemFrame reports if frame is a function of the runtime or the
+// testing package.
+func isSystemFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, "runtime.") || strings.HasPrefix(frame.Function, "testing.")
+}
+
+// stackTrace returns the stack trace of the calling goroutine, skipping
+// the given number of frames (0 is the caller of stackTrace), filtered
+// according to the stack mode.
+func stackTrace(skip int) string {
+	pc := make([]uintptr, 64)
+	n := runtime.Callers(skip+2, pc)
+	frames := runtime.CallersFrames(pc[:n])
+	var all []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		all = append(all, frame)
+		if !more {
+			break
+		}
+	}
+	var lines []string
+	for i, frame := range all {
+		if stackMode != StackFull {
+			user := !isLibraryFrame(frame) && !isSystemFrame(frame)
+			// the assertion is the library frame called by user code
+			assertion := stackMode == StackUserAndAssertion && isLibraryFrame(frame) &&
+				i+1 < len(all) && !isLibraryFrame(all[i+1]) && !isSystemFrame(all[i+1])
+			if !user && !assertion {
+				continue
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s\n\t%s:%d", frame.Function, frame.File, frame.Line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+var callerFrames = 1
+
+// SetCallerFrames sets the number of frames of user code reported with the
+// failures of assertions (1 by default): the source snippet of the failing
+// call, then the locations of its callers, which is useful for assertions
+// in helper functions that do not call t.Helper.
+// Zero disables the lookup of callers entirely, which saves its cost in
+// table-driven tests with a very large number of failing cases.
+// It is not safe to call concurrently with assertions, so it should be
+// called in an init function or TestMain.
+func SetCallerFrames(n int) {
+	callerFrames = n
+}
+
+// userFrames returns the first n frames of user code in the stack of the
+// calling goroutine, skipping the given number of frames (0 is the caller
+// of userFrames).
+func userFrames(skip int, n int) []runtime.Frame {
+	pc := make([]uintptr, 64)
+	count := runtime.Callers(skip+2, pc)
+	frames := runtime.CallersFrames(pc[:count])
+	var result []runtime.Frame
+	for len(result) < n {
+		frame, more := frames.Next()
+		if !isLibraryFrame(frame) && !isSystemFrame(frame) {
+			result = append(result, frame)
+		}
+		if !more {
+			break
+		}
+	}
+	return result
+}
+
+// callerInfo returns the source snippet of the first frame of user code,
+// and the locations of the following callerFrames-1 frames of user code,
+// skipping the given number of frames (0 is the caller of callerInfo).
+func callerInfo(skip int) string {
+	if callerFrames <= 0 {
+		return ""
+	}
+	var lines []string
+	for i, frame := range userFrames(skip+1, callerFrames) {
+		if i == 0 {
+			if snippet := sourceSnippet(frame.File, frame.Line); snippet != "" {
+				lines = append(lines, snippet)
+			}
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("\tcalled from %s:%d", frame.File, frame.Line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// snippetContext is the number of lines shown before and after the line
+// of the failing call in source snippets.
+const snippetContext = 1
+
+// sourceSnippet returns the lines of the file around the given line, with
+// line numbers, and the given line marked with ">", or "" if the file can
+// not be read. The common indentation of the lines is removed.
+func sourceSnippet(file string, line int) string {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	lines := strings.Split(string(data), "\n")
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+	start := line - snippetContext
+	if start < 1 {
+		start = 1
+	}
+	end := line + snippetContext
+	if end > len(lines) {
+		end = len(lines)
+	}
+	indent := -1
+	for i := start; i <= end; i++ {
+		text := lines[i-1]
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+		n := len(text) - len(strings.TrimLeft(text, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	width := len(fmt.Sprint(end))
+	var sb strings.Builder
+	for i := start; i <= end; i++ {
+		text := strings.TrimRight(lines[i-1], " \t\r")
+		if len(text) >= indent && indent > 0 {
+			text = text[indent:]
+		}
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&sb, "\t%s %*d | %s\n", marker, width, i, text)
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
@@ -0,0 +1,110 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// ownedT is the TestingT of Assertions created by New, which knows when
+// the test it was created for has finished, so that failures reported to
+// it afterwards, for example from a goroutine or from a closure of a
+// parallel subtest that captured the Assertions of its parent, are
+// reported clearly instead of with the panic of the testing package.
+type ownedT struct {
+	TestingT
+	finished *atomic.Bool
+}
+
+// newOwnedT returns t wrapped in an ownedT, registering a cleanup on t to
+// track when it finishes.
+func newOwnedT(t TestingT) TestingT {
+	if isNil(t) {
+		return t
+	}
+	finished := new(atomic.Bool)
+	t.Cleanup(func() {
+		finished.Store(true)
+	})
+	return &ownedT{TestingT: t, finished: finished}
+}
+
+// checkStale must be deferred by the failure methods of ownedT. It turns
+// the panic of the testing package on reporting a failure to a finished
+// test into a panic explaining the stale usage, including the failure
+// that would otherwise be lost.
+func (o *ownedT) checkStale(msg string) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if s, ok := r.(string); !ok || !o.finished.Load() || !strings.Contains(s, " has completed") {
+		panic(r)
+	}
+	panic(fmt.Sprintf(
+		"require: assertion failed after test %s finished, using Assertions created by require.New for that test.\n"+
+			"Create Assertions from the t of the running test instead, for example in t.Run closures with t.Parallel:\n"+
+			"\tt.Run(name, func(t *testing.T) {\n\t\tt.Parallel()\n\t\tr := require.New(t)\n\t\t...\n\t})\n"+
+			"The failure was:\n%s",
+		o.TestingT.Name(), msg,
+	))
+}
+
+func (o *ownedT) Error(args ...any) {
+	o.TestingT.Helper()
+	defer o.checkStale(fmt.Sprint(args...))
+	o.TestingT.Error(args...)
+}
+
+func (o *ownedT) Errorf(format string, args ...any) {
+	o.TestingT.Helper()
+	defer o.checkStale(fmt.Sprintf(format, args...))
+	o.TestingT.Errorf(format, args...)
+}
+
+func (o *ownedT) Fatal(args ...any) {
+	o.TestingT.Helper()
+	defer o.checkStale(fmt.Sprint(args...))
+	o.TestingT.Fatal(args...)
+}
+
+func (o *ownedT) Fatalf(format string, args ...any) {
+	o.TestingT.Helper()
+	defer o.checkStale(fmt.Sprintf(format, args...))
+	o.TestingT.Fatalf(format, args...)
+}
+
+func (o *ownedT) Fail() {
+	o.TestingT.Helper()
+	defer o.checkStale("")
+	o.TestingT.Fail()
+}
+
+func (o *ownedT) FailNow() {
+	o.TestingT.Helper()
+	defer o.checkStale("")
+	o.TestingT.FailNow()
+}
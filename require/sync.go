@@ -0,0 +1,188 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// goroutineID returns the ID of the calling goroutine, parsed from its
+// stack trace, since it is not exposed otherwise.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	// the trace starts with "goroutine 123 [running]:"
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+// syncT is a TestingT that can be used by assertions from any goroutine,
+// see Sync.
+type syncT struct {
+	TestingT
+	testGoroutine uint64
+
+	mu sync.Mutex
+	// stopPending is set when a fatal failure was reported from another
+	// goroutine, so that the test goroutine stops at its next report.
+	stopPending bool
+	// done is set when the test has completed, after which failures can
+	// not be reported to it anymore.
+	done bool
+}
+
+// begin locks s before reporting to the test, and returns true, unless the
+// test has completed, in which case msg is printed to stderr instead.
+func (s *syncT) begin(msg string) bool {
+	s.mu.Lock()
+	if s.done {
+		s.mu.Unlock()
+		fmt.Fprintf(os.Stderr, "%s: reported after the test completed: %s\n", s.Name(), msg)
+		return false
+	}
+	return true
+}
+
+// end unlocks s after reporting to the test, and stops the test if called
+// from the test goroutine after a fatal failure, or marks it to be stopped
+// later if called from another goroutine.
+func (s *syncT) end(fatal bool) {
+	onTest := goroutineID() == s.testGoroutine
+	if fatal && !onTest {
+		s.stopPending = true
+	}
+	stop := onTest && (fatal || s.stopPending)
+	s.mu.Unlock()
+	if stop {
+		s.TestingT.FailNow()
+	}
+}
+
+func (s *syncT) Error(args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprint(args...)) {
+		s.TestingT.Error(args...)
+		s.end(false)
+	}
+}
+
+func (s *syncT) Errorf(format string, args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprintf(format, args...)) {
+		s.TestingT.Errorf(format, args...)
+		s.end(false)
+	}
+}
+
+func (s *syncT) Fatal(args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprint(args...)) {
+		s.TestingT.Error(args...)
+		s.end(true)
+	}
+}
+
+func (s *syncT) Fatalf(format string, args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprintf(format, args...)) {
+		s.TestingT.Errorf(format, args...)
+		s.end(true)
+	}
+}
+
+func (s *syncT) Fail() {
+	if s.begin("Fail called") {
+		s.TestingT.Fail()
+		s.end(false)
+	}
+}
+
+func (s *syncT) FailNow() {
+	if s.begin("FailNow called") {
+		s.TestingT.Fail()
+		s.end(true)
+	}
+}
+
+func (s *syncT) Log(args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprint(args...)) {
+		s.TestingT.Log(args...)
+		s.end(false)
+	}
+}
+
+func (s *syncT) Logf(format string, args ...any) {
+	s.TestingT.Helper()
+	if s.begin(fmt.Sprintf(format, args...)) {
+		s.TestingT.Logf(format, args...)
+		s.end(false)
+	}
+}
+
+// Sync returns a TestingT that assertions can use from any goroutine, such
+// as goroutines started by the test or by the code under test. It must be
+// called from the test goroutine.
+//
+// A fatal failure in another goroutine is reported without stopping that
+// goroutine (FailNow must only be called from the test goroutine): the
+// assertion returns, like assertions of testify/assert, and the test is
+// stopped at the next failure or log reported from the test goroutine.
+// Failures reported after the test has completed, which would otherwise
+// crash the test binary, are printed to stderr instead.
+//
+//	st := require.Sync(t)
+//	go func() {
+//		defer wg.Done()
+//		require.NoError(st, worker.Run())
+//	}()
+func Sync(t TestingT) TestingT {
+	if s, ok := t.(*syncT); ok {
+		return s
+	}
+	s := &syncT{
+		TestingT:      t,
+		testGoroutine: goroutineID(),
+	}
+	t.Cleanup(func() {
+		s.mu.Lock()
+		s.done = true
+		s.mu.Unlock()
+	})
+	return s
+}
+
+// Sync returns Assertions that can be used from any goroutine, see Sync.
+func (a *Assertions) Sync() *Assertions {
+	return &Assertions{t: Sync(a.t)}
+}
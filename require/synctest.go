@@ -0,0 +1,41 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"bytes"
+	"runtime"
+)
+
+// inSynctestBubble reports if the calling goroutine runs in a bubble of
+// testing/synctest, parsed from its stack trace like goroutineID, since it
+// is not exposed otherwise. The goroutines started in a bubble must exit
+// before it ends, and the time package uses its fake clock.
+func inSynctestBubble() bool {
+	buf := make([]byte, 128)
+	buf = buf[:runtime.Stack(buf, false)]
+	// the trace starts with "goroutine 123 [running, synctest bubble 1]:"
+	header, _, _ := bytes.Cut(buf, []byte("\n"))
+	return bytes.Contains(header, []byte("synctest bubble"))
+}
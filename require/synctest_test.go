@@ -0,0 +1,87 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:build go1.25
+
+package require
+
+import (
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestSynctestEventually(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var calls atomic.Int32
+		start := time.Now()
+		ft := newFakeT(t)
+		Eventually(ft, func() bool {
+			return calls.Add(1) == 3
+		}, time.Hour, time.Minute)
+		expectFailed(t, ft, false)
+		if elapsed := time.Since(start); elapsed != 3*time.Minute {
+			t.Errorf("expected 3 ticks of the fake clock, got %v", elapsed)
+		}
+
+		ft = newFakeT(t)
+		Eventually(ft, func() bool { return false }, time.Hour, time.Minute)
+		expectFailed(t, ft, true)
+	})
+}
+
+func TestSynctestNever(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		start := time.Now()
+		ft := newFakeT(t)
+		Never(ft, func() bool { return false }, time.Hour, time.Minute)
+		expectFailed(t, ft, false)
+		if elapsed := time.Since(start); elapsed != time.Hour {
+			t.Errorf("expected to wait an hour of the fake clock, got %v", elapsed)
+		}
+
+		ft = newFakeT(t)
+		Never(ft, func() bool { return true }, time.Hour, time.Minute)
+		expectFailed(t, ft, true)
+	})
+}
+
+// TestSynctestSlowCondition checks that a condition still running at the
+// timeout does not outlive the assertion, which would make synctest.Test
+// fail with a goroutine blocked after the bubble ends.
+func TestSynctestSlowCondition(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var done atomic.Bool
+		ft := newFakeT(t)
+		Eventually(ft, func() bool {
+			time.Sleep(2 * time.Hour)
+			done.Store(true)
+			return false
+		}, time.Hour, time.Minute)
+		expectFailed(t, ft, true)
+		if !done.Load() {
+			t.Error("expected the assertion to wait for the running condition")
+		}
+	})
+}
@@ -0,0 +1,120 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+// Subject is a value checked by a chain of assertions, see That.
+type Subject[T any] struct {
+	t      TestingT
+	actual T
+}
+
+// That returns a Subject for chaining assertions on actual, in subject-first
+// reading order:
+//
+//	require.That(t, user.Name).Equals("alice").NotEmpty()
+//	require.That(t, ids).HasLen(3).Contains(42)
+//
+// The expected values of Equals and NotEquals have the type of actual, so
+// untyped constants are converted to it, and mismatching types are compile
+// errors. Each assertion stops the test on failure, like the functions it
+// calls, and returns the Subject for the next assertion otherwise.
+func That[T any](t TestingT, actual T) *Subject[T] {
+	return &Subject[T]{t: t, actual: actual}
+}
+
+// Value returns the value of the subject.
+func (s *Subject[T]) Value() T {
+	return s.actual
+}
+
+// Equals asserts that the value is equal to expected, see Equal.
+func (s *Subject[T]) Equals(expected T, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Equal(s.t, expected, s.actual, msgAndArgs...)
+	return s
+}
+
+// NotEquals asserts that the value is not equal to expected, see NotEqual.
+func (s *Subject[T]) NotEquals(expected T, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	NotEqual(s.t, expected, s.actual, msgAndArgs...)
+	return s
+}
+
+// IsNil asserts that the value is nil, see Nil.
+func (s *Subject[T]) IsNil(msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Nil(s.t, s.actual, msgAndArgs...)
+	return s
+}
+
+// NotNil asserts that the value is not nil, see NotNil.
+func (s *Subject[T]) NotNil(msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	NotNil(s.t, s.actual, msgAndArgs...)
+	return s
+}
+
+// IsEmpty asserts that the value is empty, see Empty.
+func (s *Subject[T]) IsEmpty(msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Empty(s.t, s.actual, msgAndArgs...)
+	return s
+}
+
+// NotEmpty asserts that the value is not empty, see NotEmpty.
+func (s *Subject[T]) NotEmpty(msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	NotEmpty(s.t, s.actual, msgAndArgs...)
+	return s
+}
+
+// HasLen asserts that the value has the given length, see Len.
+func (s *Subject[T]) HasLen(length int, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Len(s.t, s.actual, length, msgAndArgs...)
+	return s
+}
+
+// Contains asserts that the value contains element, see Contains.
+func (s *Subject[T]) Contains(element any, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Contains(s.t, s.actual, element, msgAndArgs...)
+	return s
+}
+
+// NotContains asserts that the value does not contain element, see
+// NotContains.
+func (s *Subject[T]) NotContains(element any, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	NotContains(s.t, s.actual, element, msgAndArgs...)
+	return s
+}
+
+// Matches asserts that the value matches matcher, see Match.
+func (s *Subject[T]) Matches(matcher Matcher, msgAndArgs ...any) *Subject[T] {
+	s.t.Helper()
+	Match(s.t, s.actual, matcher, msgAndArgs...)
+	return s
+}
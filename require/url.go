@@ -0,0 +1,135 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// normalizeURL returns the components of a URL for comparison by URLEqual:
+// lower-case scheme and host, without the default port of the scheme,
+// unescaped path ("/" if empty and there is a host), or the opaque part of
+// URLs like "mailto:alice@example.com", and the query values with the
+// values of each parameter sorted.
+func normalizeURL(u *url.URL) (base string, query url.Values) {
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Host)
+	switch {
+	case scheme == "http" && strings.HasSuffix(host, ":80"):
+		host = strings.TrimSuffix(host, ":80")
+	case scheme == "https" && strings.HasSuffix(host, ":443"):
+		host = strings.TrimSuffix(host, ":443")
+	}
+	path := u.Path
+	if path == "" && host != "" {
+		path = "/"
+	}
+	normalized := url.URL{
+		Scheme:   scheme,
+		Opaque:   u.Opaque,
+		User:     u.User,
+		Host:     host,
+		Path:     path,
+		Fragment: u.Fragment,
+	}
+	query = u.Query()
+	for _, values := range query {
+		sort.Strings(values)
+	}
+	return normalized.String(), query
+}
+
+// URLEqual asserts that the URLs are equivalent: scheme and host are
+// compared ignoring case and default ports, paths are compared unescaped,
+// and query parameters are compared as sets (ignoring their order, and the
+// order of the values of repeated parameters).
+func URLEqual(t TestingT, expected string, actual string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "URLEqual", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedURL, err := url.Parse(expected)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Invalid expected URL %q: %v", expected, err))
+		return
+	}
+	actualURL, err := url.Parse(actual)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Invalid actual URL %q: %v", actual, err))
+		return
+	}
+	expectedBase, expectedQuery := normalizeURL(expectedURL)
+	actualBase, actualQuery := normalizeURL(actualURL)
+	var diffs []string
+	if expectedBase != actualBase {
+		diffs = append(diffs, fmt.Sprintf("expected %s, actual %s", expectedBase, actualBase))
+	}
+	keys := map[string]bool{}
+	for key := range expectedQuery {
+		keys[key] = true
+	}
+	for key := range actualQuery {
+		keys[key] = true
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	for _, key := range sortedKeys {
+		if !reflect.DeepEqual(expectedQuery[key], actualQuery[key]) {
+			diffs = append(diffs, fmt.Sprintf("query parameter %q: expected %q, actual %q", key, expectedQuery[key], actualQuery[key]))
+		}
+	}
+	if len(diffs) > 0 {
+		a.Fail(fmt.Sprintf("URLs are not equal:\nexpected: %s\nactual  : %s\n\t%s", expected, actual, strings.Join(diffs, "\n\t")))
+	}
+}
+
+// QueryParamEqual asserts that the query parameter key of the URL has the
+// single value expected.
+func QueryParamEqual(t TestingT, rawURL string, key string, expected string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "QueryParamEqual", rawURL, key, expected, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Invalid URL %q: %v", rawURL, err))
+		return
+	}
+	values, ok := u.Query()[key]
+	switch {
+	case !ok:
+		a.Fail(fmt.Sprintf("URL %q has no query parameter %q, expected %q", rawURL, key, expected))
+	case len(values) != 1 || values[0] != expected:
+		a.Fail(fmt.Sprintf("URL %q query parameter %q mismatch:\nexpected: %q\nactual  : %q", rawURL, key, expected, values))
+	}
+}
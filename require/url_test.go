@@ -0,0 +1,48 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"testing"
+)
+
+func TestURLEqual(t *testing.T) {
+	tests := []struct {
+		expected, actual string
+		failed           bool
+	}{
+		{"http://example.com/a?x=1&y=2", "HTTP://Example.com:80/a?y=2&x=1", false},
+		{"https://example.com", "https://example.com:443/", false},
+		{"http://example.com/a?x=1", "http://example.com/a?x=2", true},
+		{"mailto:alice@example.com", "mailto:alice@example.com", false},
+		{"mailto:alice@example.com", "mailto:bob@example.com", true},
+	}
+	for _, tt := range tests {
+		ft := newFakeT(t)
+		URLEqual(ft, tt.expected, tt.actual)
+		if ft.Failed() != tt.failed {
+			t.Errorf("URLEqual(%q, %q) failed = %v, expected %v", tt.expected, tt.actual, ft.Failed(), tt.failed)
+		}
+	}
+}
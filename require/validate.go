@@ -0,0 +1,272 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"strings"
+)
+
+// validateUUID checks that s is a UUID in the canonical textual form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+func validateUUID(s string) error {
+	if len(s) != 36 {
+		return fmt.Errorf("length is %d, expected 36", len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return fmt.Errorf("expected '-' at index %d, found %q", i, c)
+			}
+		default:
+			if !isHexDigit(c) {
+				return fmt.Errorf("invalid hex digit %q at index %d", c, i)
+			}
+		}
+	}
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'f' || 'A' <= c && c <= 'F'
+}
+
+func isAlphanumeric(c byte) bool {
+	return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// validateSemverIdentifiers checks the dot-separated pre-release or build
+// identifiers of a semantic version.
+func validateSemverIdentifiers(kind string, s string, numeric bool) error {
+	for _, ident := range strings.Split(s, ".") {
+		if ident == "" {
+			return fmt.Errorf("empty %s identifier", kind)
+		}
+		allDigits := true
+		for i := 0; i < len(ident); i++ {
+			c := ident[i]
+			if c != '-' && !isAlphanumeric(c) {
+				return fmt.Errorf("invalid character %q in %s identifier %q", c, kind, ident)
+			}
+			if c < '0' || c > '9' {
+				allDigits = false
+			}
+		}
+		if numeric && allDigits && len(ident) > 1 && ident[0] == '0' {
+			return fmt.Errorf("numeric %s identifier %q has a leading zero", kind, ident)
+		}
+	}
+	return nil
+}
+
+// validateSemver checks that s is a semantic version as defined by
+// https://semver.org (MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]).
+func validateSemver(s string) error {
+	if strings.HasPrefix(s, "v") {
+		return errors.New("unexpected 'v' prefix")
+	}
+	version := s
+	if i := strings.IndexByte(version, '+'); i >= 0 {
+		if err := validateSemverIdentifiers("build", version[i+1:], false); err != nil {
+			return err
+		}
+		version = version[:i]
+	}
+	if i := strings.IndexByte(version, '-'); i >= 0 {
+		if err := validateSemverIdentifiers("pre-release", version[i+1:], true); err != nil {
+			return err
+		}
+		version = version[:i]
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("expected MAJOR.MINOR.PATCH, found %d dot-separated parts in %q", len(parts), version)
+	}
+	for i, part := range parts {
+		name := [...]string{"major", "minor", "patch"}[i]
+		if part == "" {
+			return fmt.Errorf("empty %s version", name)
+		}
+		for j := 0; j < len(part); j++ {
+			if part[j] < '0' || part[j] > '9' {
+				return fmt.Errorf("%s version %q is not a number", name, part)
+			}
+		}
+		if len(part) > 1 && part[0] == '0' {
+			return fmt.Errorf("%s version %q has a leading zero", name, part)
+		}
+	}
+	return nil
+}
+
+// validateEmail checks that s is a bare e-mail address (without a display
+// name or angle brackets).
+func validateEmail(s string) error {
+	addr, err := mail.ParseAddress(s)
+	if err != nil {
+		return err
+	}
+	if addr.Address != s {
+		return fmt.Errorf("expected a bare address, parsed as %q", addr.Address)
+	}
+	return nil
+}
+
+// validateIP checks that s is an IP address, of the given version (4 or 6)
+// if version is not zero.
+func validateIP(s string, version int) error {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return err
+	}
+	switch {
+	case version == 4 && !addr.Is4():
+		return errors.New("is an IPv6 address")
+	case version == 6 && !addr.Is6():
+		return errors.New("is an IPv4 address")
+	}
+	return nil
+}
+
+// validateHostname checks that s is a hostname as defined by RFC 1123:
+// dot-separated labels of 1 to 63 letters, digits and hyphens, not starting
+// or ending with a hyphen, and at most 253 characters in total (excluding
+// an optional trailing dot).
+func validateHostname(s string) error {
+	name := strings.TrimSuffix(s, ".")
+	if name == "" {
+		return errors.New("empty hostname")
+	}
+	if len(name) > 253 {
+		return fmt.Errorf("length is %d, expected at most 253", len(name))
+	}
+	for _, label := range strings.Split(name, ".") {
+		switch {
+		case label == "":
+			return errors.New("empty label")
+		case len(label) > 63:
+			return fmt.Errorf("label %q is %d characters long, expected at most 63", label, len(label))
+		case label[0] == '-' || label[len(label)-1] == '-':
+			return fmt.Errorf("label %q starts or ends with a hyphen", label)
+		}
+		for i := 0; i < len(label); i++ {
+			if c := label[i]; c != '-' && !isAlphanumeric(c) {
+				return fmt.Errorf("invalid character %q in label %q", c, label)
+			}
+		}
+	}
+	return nil
+}
+
+func validate(t TestingT, kind string, s string, err error, msgAndArgs []any) {
+	t.Helper()
+	if err == nil {
+		return
+	}
+	a := newAsserter(t, msgAndArgs)
+	a.Fail(fmt.Sprintf("%q is not a valid %s: %v", s, kind, err))
+}
+
+// IsUUID asserts that s is a UUID in the canonical textual form
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx (of any version, in any case).
+func IsUUID(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsUUID", s, msgAndArgs)()
+	}
+	validate(t, "UUID", s, validateUUID(s), msgAndArgs)
+}
+
+// IsSemver asserts that s is a semantic version as defined by
+// https://semver.org, without a "v" prefix.
+func IsSemver(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsSemver", s, msgAndArgs)()
+	}
+	validate(t, "semantic version", s, validateSemver(s), msgAndArgs)
+}
+
+// IsEmail asserts that s is an RFC 5322 e-mail address, without a display
+// name.
+func IsEmail(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsEmail", s, msgAndArgs)()
+	}
+	validate(t, "e-mail address", s, validateEmail(s), msgAndArgs)
+}
+
+// IsIP asserts that s is an IPv4 or IPv6 address.
+func IsIP(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsIP", s, msgAndArgs)()
+	}
+	validate(t, "IP address", s, validateIP(s, 0), msgAndArgs)
+}
+
+// IsIPv4 asserts that s is an IPv4 address.
+func IsIPv4(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsIPv4", s, msgAndArgs)()
+	}
+	validate(t, "IPv4 address", s, validateIP(s, 4), msgAndArgs)
+}
+
+// IsIPv6 asserts that s is an IPv6 address (including IPv4-mapped IPv6
+// addresses such as "::ffff:10.0.0.1").
+func IsIPv6(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsIPv6", s, msgAndArgs)()
+	}
+	validate(t, "IPv6 address", s, validateIP(s, 6), msgAndArgs)
+}
+
+// IsCIDR asserts that s is an IP prefix in CIDR notation, like
+// "192.168.0.0/16" or "2001:db8::/32".
+func IsCIDR(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsCIDR", s, msgAndArgs)()
+	}
+	_, err := netip.ParsePrefix(s)
+	validate(t, "CIDR prefix", s, err, msgAndArgs)
+}
+
+// IsHostname asserts that s is a hostname as defined by RFC 1123.
+func IsHostname(t TestingT, s string, msgAndArgs ...any) {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "IsHostname", s, msgAndArgs)()
+	}
+	validate(t, "hostname", s, validateHostname(s), msgAndArgs)
+}
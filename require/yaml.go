@@ -0,0 +1,261 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package require
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// yamlDocuments parses the documents of a YAML stream.
+func yamlDocuments(s string) ([]*yaml.Node, error) {
+	dec := yaml.NewDecoder(strings.NewReader(s))
+	var docs []*yaml.Node
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, &doc)
+	}
+}
+
+// yamlValue decodes a YAML node to the Go value that yaml.Unmarshal would
+// decode it to, for comparing the nodes semantically.
+func yamlValue(node *yaml.Node) any {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		// keep the node, which is then only equal to itself
+		return node
+	}
+	return v
+}
+
+// yamlNode resolves document and alias nodes to the node of their content.
+func yamlNode(node *yaml.Node) *yaml.Node {
+	for node != nil {
+		switch node.Kind {
+		case yaml.DocumentNode:
+			if len(node.Content) == 0 {
+				return nil
+			}
+			node = node.Content[0]
+		case yaml.AliasNode:
+			node = node.Alias
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+var yamlPlainKeyRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// yamlKeyPath returns the path of the value of key in the mapping at path,
+// like "metadata.name", or `metadata.labels["app.kubernetes.io/name"]` for
+// keys that are not plain identifiers.
+func yamlKeyPath(path string, key string) string {
+	if !yamlPlainKeyRe.MatchString(key) {
+		return path + "[" + strconv.Quote(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// yamlScalar formats a scalar node for diffs.
+func yamlScalar(node *yaml.Node) string {
+	if node.Tag == "!!null" {
+		return "null"
+	}
+	if node.Tag == "!!str" && (node.Value == "" || strings.ContainsAny(node.Value, "\n\t") ||
+		strings.TrimSpace(node.Value) != node.Value || node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0) {
+		return strconv.Quote(node.Value)
+	}
+	return node.Value
+}
+
+// yamlDiff collects the differences of YAML nodes as lines of the form
+// "- path: value" for expected values and "+ path: value" for actual ones,
+// in the order of the keys in the documents.
+type yamlDiff struct {
+	lines []string
+}
+
+func (d *yamlDiff) add(sign string, path string, node *yaml.Node) {
+	if path == "" {
+		path = "(document)"
+	}
+	node = yamlNode(node)
+	switch {
+	case node == nil:
+		d.lines = append(d.lines, sign+" "+path+": null")
+	case node.Kind == yaml.MappingNode && len(node.Content) > 0:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			d.add(sign, yamlKeyPath(strings.TrimPrefix(path, "(document)"), node.Content[i].Value), node.Content[i+1])
+		}
+	case node.Kind == yaml.MappingNode:
+		d.lines = append(d.lines, sign+" "+path+": {}")
+	case node.Kind == yaml.SequenceNode && len(node.Content) > 0:
+		for i, item := range node.Content {
+			d.add(sign, fmt.Sprintf("%s[%d]", strings.TrimPrefix(path, "(document)"), i), item)
+		}
+	case node.Kind == yaml.SequenceNode:
+		d.lines = append(d.lines, sign+" "+path+": []")
+	default:
+		d.lines = append(d.lines, sign+" "+path+": "+yamlScalar(node))
+	}
+}
+
+// compare adds the differences between the expected and actual nodes at
+// path. Keys of mappings are compared in the order of expected, followed
+// by the keys only in actual, in their order.
+func (d *yamlDiff) compare(path string, expected, actual *yaml.Node) {
+	expected, actual = yamlNode(expected), yamlNode(actual)
+	if expected == nil || actual == nil || expected.Kind != actual.Kind {
+		if expected != nil || actual != nil {
+			d.add("-", path, expected)
+			d.add("+", path, actual)
+		}
+		return
+	}
+	switch expected.Kind {
+	case yaml.MappingNode:
+		actualValues := map[string]*yaml.Node{}
+		for i := 0; i+1 < len(actual.Content); i += 2 {
+			actualValues[actual.Content[i].Value] = actual.Content[i+1]
+		}
+		expectedKeys := map[string]bool{}
+		for i := 0; i+1 < len(expected.Content); i += 2 {
+			key := expected.Content[i].Value
+			expectedKeys[key] = true
+			keyPath := yamlKeyPath(path, key)
+			if actualValue, ok := actualValues[key]; ok {
+				d.compare(keyPath, expected.Content[i+1], actualValue)
+			} else {
+				d.add("-", keyPath, expected.Content[i+1])
+			}
+		}
+		for i := 0; i+1 < len(actual.Content); i += 2 {
+			if key := actual.Content[i].Value; !expectedKeys[key] {
+				d.add("+", yamlKeyPath(path, key), actual.Content[i+1])
+			}
+		}
+	case yaml.SequenceNode:
+		for i := 0; i < len(expected.Content) || i < len(actual.Content); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(actual.Content):
+				d.add("-", itemPath, expected.Content[i])
+			case i >= len(expected.Content):
+				d.add("+", itemPath, actual.Content[i])
+			default:
+				d.compare(itemPath, expected.Content[i], actual.Content[i])
+			}
+		}
+	default:
+		if !reflect.DeepEqual(yamlValue(expected), yamlValue(actual)) {
+			d.add("-", path, expected)
+			d.add("+", path, actual)
+		}
+	}
+}
+
+// yamlDocumentsDiff returns the differences between the documents of YAML
+// streams, see yamlDiff. The paths of streams with several documents are
+// prefixed by the index of the document, like "[1] spec.replicas".
+func yamlDocumentsDiff(expected, actual []*yaml.Node) string {
+	var lines []string
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		var d yamlDiff
+		switch {
+		case i >= len(actual):
+			d.add("-", "", expected[i])
+		case i >= len(expected):
+			d.add("+", "", actual[i])
+		case reflect.DeepEqual(yamlValue(expected[i]), yamlValue(actual[i])):
+			continue
+		default:
+			d.compare("", expected[i], actual[i])
+		}
+		for _, line := range d.lines {
+			if len(expected) > 1 || len(actual) > 1 {
+				line = fmt.Sprintf("%s [%d] %s", line[:1], i, line[2:])
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// YAMLEq asserts that the YAML documents are semantically equal, ignoring
+// formatting, comments and the order of keys. A stream of several
+// documents (separated by "---") is compared document by document.
+//
+// The failure message shows the differing values by path, in the order of
+// the keys in the documents, like:
+//
+//	YAML not equal (- expected, + actual):
+//	- spec.replicas: 3
+//	+ spec.replicas: 5
+//	- metadata.labels["app.kubernetes.io/name"]: web
+//	+ spec.template.spec.containers[0].image: nginx:1.27
+//
+// Paths are prefixed by the index of the document, like "[1] spec", if a
+// stream has several documents.
+func YAMLEq(t TestingT, expected string, actual string, msgAndArgs ...any) bool {
+	t.Helper()
+	if it := findIntercept(t); it != nil {
+		defer it.intercept(t, "YAMLEq", expected, actual, msgAndArgs)()
+	}
+	a := newAsserter(t, msgAndArgs)
+	expectedDocs, err := yamlDocuments(expected)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Expected value ('%s') is not valid YAML: %v", expected, err))
+		return false
+	}
+	actualDocs, err := yamlDocuments(actual)
+	if err != nil {
+		a.Fail(fmt.Sprintf("Input ('%s') needs to be valid YAML: %v", actual, err))
+		return false
+	}
+	diff := yamlDocumentsDiff(expectedDocs, actualDocs)
+	if diff == "" {
+		return true
+	}
+	a.Fail("YAML not equal (- expected, + actual):\n" + diff)
+	return false
+}
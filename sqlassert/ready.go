@@ -0,0 +1,124 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package sqlassert
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ilius/demand/require"
+)
+
+const (
+	// readyRetryMin and readyRetryMax are the bounds of the delay between
+	// attempts of EventuallyDBReady, which doubles after each attempt.
+	readyRetryMin = 10 * time.Millisecond
+	readyRetryMax = time.Second
+	// readyDeadlineMargin is the time left before the test deadline for
+	// reporting the failure of EventuallyDBReady.
+	readyDeadlineMargin = time.Second
+)
+
+// DBReadyOptions are the options of EventuallyDBReadyWith.
+type DBReadyOptions struct {
+	// Query is a sanity query run after a successful ping, such as
+	// "SELECT 1 FROM users LIMIT 1" for waiting for migrations. Its rows
+	// are ignored. No query is run if empty.
+	Query string
+}
+
+// checkReady pings db, and runs the sanity query of opts if any.
+func checkReady(ctx context.Context, db *sql.DB, opts DBReadyOptions) error {
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	if opts.Query == "" {
+		return nil
+	}
+	rows, err := db.QueryContext(ctx, opts.Query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+	}
+	return rows.Err()
+}
+
+// EventuallyDBReady asserts that db can be pinged within timeout, retrying
+// failed attempts with an exponential backoff, for waiting for a database
+// started by integration tests to accept connections:
+//
+//	db, err := sql.Open("pgx", dsn)
+//	require.NoError(t, err)
+//	sqlassert.EventuallyDBReady(t, db, 30*time.Second)
+//
+// On failure, the last error of the driver is reported.
+func EventuallyDBReady(t require.TestingT, db *sql.DB, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	EventuallyDBReadyWith(t, db, DBReadyOptions{}, timeout, msgAndArgs...)
+}
+
+// EventuallyDBReadyWith is like EventuallyDBReady, with options.
+//
+// The timeout is capped at the test deadline, see require.TestDeadline. Unlike require.EventuallyHealthy, the retries always
+// run on the real time: they ignore require.WithClock and
+// require.WithBudget. Use DBProbe for those.
+func EventuallyDBReadyWith(t require.TestingT, db *sql.DB, opts DBReadyOptions, timeout time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if deadline, ok := require.TestDeadline(t); ok {
+		timeout = min(timeout, max(time.Until(deadline)-readyDeadlineMargin, 0))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	delay := readyRetryMin
+	attempts := 0
+	var lastErr error
+	for {
+		attempts++
+		lastErr = checkReady(ctx, db, opts)
+		if lastErr == nil {
+			return
+		}
+		if deadline, _ := ctx.Deadline(); time.Until(deadline) <= delay {
+			break
+		}
+		time.Sleep(delay)
+		delay *= 2
+		if delay > readyRetryMax {
+			delay = readyRetryMax
+		}
+	}
+	require.Fail(t, fmt.Sprintf("database not ready within %v (%d attempts), last error: %v", timeout, attempts, lastErr), msgAndArgs...)
+}
+
+// DBProbe returns a require.Probe pinging db, and running the sanity query
+// of opts if any, for require.EventuallyHealthy.
+func DBProbe(db *sql.DB, opts DBReadyOptions) require.Probe {
+	return require.NewProbe("database", func(ctx context.Context) error {
+		return checkReady(ctx, db, opts)
+	})
+}
@@ -0,0 +1,383 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package sqlassert provides assertions on the results of SQL queries, for
+// repository-layer tests that run queries against a test database:
+//
+//	sqlassert.QueryReturns(t, db, "SELECT id, name FROM users WHERE id = ?", []any{1}, []map[string]any{
+//		{"id": 1, "name": "alice"},
+//	})
+//	sqlassert.RowCount(t, db, "SELECT * FROM users", nil, 3)
+//
+// Rows are compared column by column, converting expected values to the
+// types of the scanned values where possible (for example int to int64),
+// and mismatches are reported per row.
+package sqlassert
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ilius/demand/check"
+	"github.com/ilius/demand/require"
+)
+
+// Queryer runs queries, implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// runQuery runs the query and returns the rows, failing on error.
+func runQuery(t require.TestingT, db Queryer, query string, args []any, msgAndArgs []any) (*sql.Rows, bool) {
+	rows, err := db.QueryContext(context.Background(), query, args...)
+	if err != nil {
+		require.Fail(t, fmt.Sprintf("query failed: %v\nquery: %s\nargs: %v", err, query, args), msgAndArgs...)
+		return nil, false
+	}
+	return rows, true
+}
+
+// scanMaps scans all rows into maps of column name to value. []byte values
+// are converted to string, since drivers commonly return text as []byte.
+func scanMaps(rows *sql.Rows) ([]map[string]any, error) {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []map[string]any
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]any, len(columns))
+		for i, column := range columns {
+			if b, ok := values[i].([]byte); ok {
+				values[i] = string(b)
+			}
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// QueryReturns asserts that the query returns the expected rows, in order.
+// Each row is given as a map of column name to value, and all the columns
+// of the query must be given.
+func QueryReturns(t require.TestingT, db Queryer, query string, args []any, expectedRows []map[string]any, msgAndArgs ...any) {
+	t.Helper()
+	rows, ok := runQuery(t, db, query, args, msgAndArgs)
+	if !ok {
+		return
+	}
+	actualRows, err := scanMaps(rows)
+	if err != nil {
+		require.Fail(t, fmt.Sprintf("scanning rows failed: %v\nquery: %s", err, query), msgAndArgs...)
+		return
+	}
+	diffs := diffRows(expectedRows, actualRows)
+	if len(diffs) > 0 {
+		require.Fail(t, fmt.Sprintf("query returned unexpected rows:\n\t%s\nquery: %s", strings.Join(diffs, "\n\t"), query), msgAndArgs...)
+	}
+}
+
+// diffRows returns the differences of rows, one per column or row.
+func diffRows(expected []map[string]any, actual []map[string]any) []string {
+	var diffs []string
+	if len(expected) != len(actual) {
+		diffs = append(diffs, fmt.Sprintf("expected %d rows, actual %d rows", len(expected), len(actual)))
+	}
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		switch {
+		case i >= len(actual):
+			diffs = append(diffs, fmt.Sprintf("row %d: missing: %v", i, expected[i]))
+			continue
+		case i >= len(expected):
+			diffs = append(diffs, fmt.Sprintf("row %d: unexpected: %v", i, actual[i]))
+			continue
+		}
+		for _, column := range sortedKeys(expected[i], actual[i]) {
+			expectedValue, expectedOK := expected[i][column]
+			actualValue, actualOK := actual[i][column]
+			switch {
+			case !actualOK:
+				diffs = append(diffs, fmt.Sprintf("row %d: column %q: not returned by query", i, column))
+			case !expectedOK:
+				diffs = append(diffs, fmt.Sprintf("row %d: column %q: unexpected value %#v", i, column, actualValue))
+			case check.Equal(expectedValue, actualValue) != nil:
+				diffs = append(diffs, fmt.Sprintf("row %d: column %q: expected %#v, actual %#v", i, column, expectedValue, actualValue))
+			}
+		}
+	}
+	return diffs
+}
+
+func sortedKeys(maps ...map[string]any) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range maps {
+		for key := range m {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// RowCount asserts that the query returns count rows.
+func RowCount(t require.TestingT, db Queryer, query string, args []any, count int, msgAndArgs ...any) {
+	t.Helper()
+	rows, ok := runQuery(t, db, query, args, msgAndArgs)
+	if !ok {
+		return
+	}
+	defer rows.Close()
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		require.Fail(t, fmt.Sprintf("reading rows failed: %v\nquery: %s", err, query), msgAndArgs...)
+		return
+	}
+	if n != count {
+		require.Fail(t, fmt.Sprintf("expected query to return %d rows, but it returned %d\nquery: %s", count, n, query), msgAndArgs...)
+	}
+}
+
+// ScansInto asserts that the query returns the expected rows, in order,
+// scanning each row into a struct of type T (see RowsEqual).
+func ScansInto[T any](t require.TestingT, db Queryer, query string, args []any, expectedRows []T, msgAndArgs ...any) {
+	t.Helper()
+	rows, ok := runQuery(t, db, query, args, msgAndArgs)
+	if !ok {
+		return
+	}
+	rowsEqual(t, rows, expectedRows, "\nquery: "+query, msgAndArgs)
+}
+
+// RowsEqual asserts that rows are equal to the expected rows, in order,
+// scanning each row into a struct of type T, and closes rows.
+//
+// Columns are matched with the fields by `db:"name"` tag, or by field name,
+// ignoring case and underscores (so column user_id matches field UserID).
+// Every column must match a field, but fields without a column are left as
+// zero values. Nullable columns can be scanned into sql.Null* or pointer
+// fields.
+func RowsEqual[T any](t require.TestingT, rows *sql.Rows, expectedRows []T, msgAndArgs ...any) {
+	t.Helper()
+	rowsEqual(t, rows, expectedRows, "", msgAndArgs)
+}
+
+// rowsEqual implements RowsEqual, adding suffix to the failure message.
+func rowsEqual[T any](t require.TestingT, rows *sql.Rows, expectedRows []T, suffix string, msgAndArgs []any) {
+	actualRows, err := scanStructs[T](rows)
+	if err != nil {
+		require.Fail(t, fmt.Sprintf("scanning rows failed: %v%s", err, suffix), msgAndArgs...)
+		return
+	}
+	diffs := diffStructRows(expectedRows, actualRows)
+	if len(diffs) > 0 {
+		require.Fail(t, fmt.Sprintf("unexpected rows:\n\t%s%s", strings.Join(diffs, "\n\t"), suffix), msgAndArgs...)
+	}
+}
+
+// StructRowsEqual asserts that the rows scanned into structs are equal,
+// reporting the mismatches per row and column name (given by `db` tag, or
+// field name).
+//
+// NULL values, given as sql.Null* (or other driver.Valuer) and pointer
+// fields, are equal regardless of the value of the invalid sql.Null*, and
+// are shown as NULL.
+func StructRowsEqual[T any](t require.TestingT, expectedRows []T, actualRows []T, msgAndArgs ...any) {
+	t.Helper()
+	diffs := diffStructRows(expectedRows, actualRows)
+	if len(diffs) > 0 {
+		require.Fail(t, fmt.Sprintf("unexpected rows:\n\t%s", strings.Join(diffs, "\n\t")), msgAndArgs...)
+	}
+}
+
+// diffStructRows returns the differences of rows, one per column or row.
+func diffStructRows[T any](expectedRows []T, actualRows []T) []string {
+	var diffs []string
+	if len(expectedRows) != len(actualRows) {
+		diffs = append(diffs, fmt.Sprintf("expected %d rows, actual %d rows", len(expectedRows), len(actualRows)))
+	}
+	for i := 0; i < len(expectedRows) || i < len(actualRows); i++ {
+		switch {
+		case i >= len(actualRows):
+			diffs = append(diffs, fmt.Sprintf("row %d: missing: %s", i, formatStruct(reflect.ValueOf(expectedRows[i]))))
+		case i >= len(expectedRows):
+			diffs = append(diffs, fmt.Sprintf("row %d: unexpected: %s", i, formatStruct(reflect.ValueOf(actualRows[i]))))
+		default:
+			diffs = append(diffs, diffStructs(i, reflect.ValueOf(expectedRows[i]), reflect.ValueOf(actualRows[i]))...)
+		}
+	}
+	return diffs
+}
+
+// columnName returns the column name of a struct field.
+func columnName(field reflect.StructField) string {
+	if tag := field.Tag.Get("db"); tag != "" {
+		return tag
+	}
+	return field.Name
+}
+
+func diffStructs(row int, expected reflect.Value, actual reflect.Value) []string {
+	var diffs []string
+	for i := 0; i < expected.NumField(); i++ {
+		field := expected.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		expectedValue := columnValue(expected.Field(i))
+		actualValue := columnValue(actual.Field(i))
+		if !reflect.DeepEqual(expectedValue, actualValue) {
+			diffs = append(diffs, fmt.Sprintf(
+				"row %d: column %q: expected %s, actual %s",
+				row, columnName(field), formatColumnValue(expectedValue), formatColumnValue(actualValue),
+			))
+		}
+	}
+	return diffs
+}
+
+// columnValue returns the value of a struct field as stored in a column:
+// nil for NULL (nil pointer, or invalid sql.Null*), the pointed value for
+// pointers, and the result of Value method for driver.Valuer.
+func columnValue(value reflect.Value) any {
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		if valuer, ok := value.Interface().(driver.Valuer); ok {
+			return valuerValue(valuer)
+		}
+		value = value.Elem()
+	}
+	if valuer, ok := value.Interface().(driver.Valuer); ok {
+		return valuerValue(valuer)
+	}
+	return value.Interface()
+}
+
+func valuerValue(valuer driver.Valuer) any {
+	v, err := valuer.Value()
+	if err != nil {
+		return valuer
+	}
+	return v
+}
+
+func formatColumnValue(value any) string {
+	if value == nil {
+		return "NULL"
+	}
+	return fmt.Sprintf("%#v", value)
+}
+
+func formatStruct(value reflect.Value) string {
+	var parts []string
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		parts = append(parts, columnName(field)+"="+formatColumnValue(columnValue(value.Field(i))))
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// normalizeName returns the name lower-cased and without underscores,
+// for matching columns with fields.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// fieldIndexes returns the index of the struct field of each column.
+func fieldIndexes(structType reflect.Type, columns []string) ([]int, error) {
+	indexes := make([]int, len(columns))
+	for i, column := range columns {
+		indexes[i] = -1
+		for j := 0; j < structType.NumField(); j++ {
+			field := structType.Field(j)
+			if !field.IsExported() {
+				continue
+			}
+			tag := field.Tag.Get("db")
+			if tag == column || (tag == "" && normalizeName(field.Name) == normalizeName(column)) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return nil, fmt.Errorf("no field of %s for column %q", structType, column)
+		}
+	}
+	return indexes, nil
+}
+
+func scanStructs[T any](rows *sql.Rows) ([]T, error) {
+	defer rows.Close()
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct type, but got %s", structType)
+	}
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := fieldIndexes(structType, columns)
+	if err != nil {
+		return nil, err
+	}
+	var result []T
+	for rows.Next() {
+		var row T
+		value := reflect.ValueOf(&row).Elem()
+		pointers := make([]any, len(columns))
+		for i, index := range indexes {
+			pointers[i] = value.Field(index).Addr().Interface()
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
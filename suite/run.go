@@ -0,0 +1,166 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package suite contains logic for creating testing suite structs
+// and running the methods on those structs as tests, similar to
+// github.com/stretchr/testify/suite.
+//
+// A suite is a struct embedding Suite, with test methods prefixed with
+// "Test", and optional lifecycle hook methods (SetupSuite, TearDownSuite,
+// SetupTest, TearDownTest, BeforeTest, AfterTest, SetupSubTest and
+// TearDownSubTest):
+//
+//	type ExampleSuite struct {
+//		suite.Suite
+//		value int
+//	}
+//
+//	func (s *ExampleSuite) SetupTest() {
+//		s.value = 5
+//	}
+//
+//	func (s *ExampleSuite) TestExample() {
+//		s.Equal(5, s.value)
+//	}
+//
+//	func TestExampleSuite(t *testing.T) {
+//		suite.Run(t, new(ExampleSuite))
+//	}
+package suite
+
+import (
+	"flag"
+	"reflect"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+var matchMethod = flag.String("demand.m", "", "regular expression to select tests of the demand suite to run")
+
+// Run takes a testing suite and runs all of the tests attached to it.
+func Run(t *testing.T, suite TestingSuite) {
+	defer recoverAndFailOnPanic(t)
+
+	suite.SetT(t)
+	suite.SetS(suite)
+
+	methodFinder := reflect.TypeOf(suite)
+	suiteName := methodFinder.Elem().Name()
+
+	var tests []testing.InternalTest
+	suiteSetupDone := false
+
+	for i := 0; i < methodFinder.NumMethod(); i++ {
+		method := methodFinder.Method(i)
+
+		ok, err := methodFilter(method.Name)
+		if err != nil {
+			t.Fatalf("demand: invalid regexp for -demand.m: %s", err)
+		}
+		if !ok {
+			continue
+		}
+
+		if !suiteSetupDone {
+			if setupAllSuite, ok := suite.(SetupAllSuite); ok {
+				setupAllSuite.SetupSuite()
+			}
+			suiteSetupDone = true
+		}
+
+		tests = append(tests, testing.InternalTest{
+			Name: method.Name,
+			F: func(t *testing.T) {
+				parentT := suite.T()
+				suite.SetT(t)
+				defer recoverAndFailOnPanic(t)
+				defer func() {
+					r := recover()
+
+					if afterTestSuite, ok := suite.(AfterTest); ok {
+						afterTestSuite.AfterTest(suiteName, method.Name)
+					}
+					if tearDownTestSuite, ok := suite.(TearDownTestSuite); ok {
+						tearDownTestSuite.TearDownTest()
+					}
+
+					suite.SetT(parentT)
+					failOnPanic(t, r)
+				}()
+
+				if setupTestSuite, ok := suite.(SetupTestSuite); ok {
+					setupTestSuite.SetupTest()
+				}
+				if beforeTestSuite, ok := suite.(BeforeTest); ok {
+					beforeTestSuite.BeforeTest(suiteName, method.Name)
+				}
+
+				method.Func.Call([]reflect.Value{reflect.ValueOf(suite)})
+			},
+		})
+	}
+
+	if suiteSetupDone {
+		defer func() {
+			if tearDownAllSuite, ok := suite.(TearDownAllSuite); ok {
+				tearDownAllSuite.TearDownSuite()
+			}
+		}()
+	}
+
+	if len(tests) == 0 {
+		t.Log("warning: no tests to run")
+		return
+	}
+	for _, test := range tests {
+		t.Run(test.Name, test.F)
+	}
+}
+
+// methodFilter filters the methods to run, by name prefix ("Test") and
+// the -demand.m flag.
+func methodFilter(name string) (bool, error) {
+	if !strings.HasPrefix(name, "Test") {
+		return false, nil
+	}
+	if *matchMethod == "" {
+		return true, nil
+	}
+	return regexp.MatchString(*matchMethod, name)
+}
+
+func recoverAndFailOnPanic(t *testing.T) {
+	t.Helper()
+	r := recover()
+	failOnPanic(t, r)
+}
+
+func failOnPanic(t *testing.T, r any) {
+	t.Helper()
+	if r != nil {
+		t.Errorf("test panicked: %v\n%s", r, debug.Stack())
+		t.FailNow()
+	}
+}
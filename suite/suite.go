@@ -0,0 +1,98 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package suite
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ilius/demand/require"
+)
+
+// Suite is a basic testing suite with methods for storing and retrieving
+// the current *testing.T context. It is meant to be embedded in user-defined
+// suite structs, which gives them all assertions of the embedded
+// *require.Assertions as methods.
+type Suite struct {
+	*require.Assertions
+
+	mutex sync.RWMutex
+	t     *testing.T
+	s     TestingSuite
+}
+
+// T retrieves the current *testing.T context.
+func (suite *Suite) T() *testing.T {
+	suite.mutex.RLock()
+	defer suite.mutex.RUnlock()
+	return suite.t
+}
+
+// SetT sets the current *testing.T context.
+func (suite *Suite) SetT(t *testing.T) {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	suite.t = t
+	suite.Assertions = require.New(t)
+}
+
+// SetS needs to set the current test suite as parent
+// to get access to the parent methods.
+func (suite *Suite) SetS(s TestingSuite) {
+	suite.s = s
+}
+
+// Require returns a require context for the suite.
+func (suite *Suite) Require() *require.Assertions {
+	suite.mutex.Lock()
+	defer suite.mutex.Unlock()
+	if suite.Assertions == nil {
+		suite.Assertions = require.New(suite.t)
+	}
+	return suite.Assertions
+}
+
+// Run provides suite functionality around golang subtests. It should be
+// called in place of t.Run(name, func(t *testing.T)) in test suite code.
+// The passed-in func will be executed as a subtest with a fresh instance of t.
+// Provides compatibility with go test pkg -run TestSuite/TestName/SubTestName.
+func (suite *Suite) Run(name string, subtest func()) bool {
+	oldT := suite.T()
+
+	return oldT.Run(name, func(t *testing.T) {
+		suite.SetT(t)
+		defer suite.SetT(oldT)
+
+		// the hooks run with the T of the subtest, so that their failures
+		// are reported for it
+		if setupSubTest, ok := suite.s.(SetupSubTest); ok {
+			setupSubTest.SetupSubTest()
+		}
+		if tearDownSubTest, ok := suite.s.(TearDownSubTest); ok {
+			defer tearDownSubTest.TearDownSubTest()
+		}
+
+		subtest()
+	})
+}
@@ -0,0 +1,356 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package assert is a drop-in replacement for
+// github.com/stretchr/testify/assert, with the same exported API, built on
+// top of the demand require package.
+//
+// Migrating from testify only needs rewriting the import paths:
+//
+//	github.com/stretchr/testify/assert  ->  github.com/ilius/demand/testify/assert
+//	github.com/stretchr/testify/require ->  github.com/ilius/demand/testify/require
+//
+// Like testify, all assertions return true on success, and report failures
+// with t.Errorf without stopping the test.
+package assert
+
+//go:generate go run ../../internal/gen assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ilius/demand/require"
+)
+
+// TestingT is an interface wrapper around *testing.T
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+type tHelper interface {
+	Helper()
+}
+
+// Comparison is a custom function that returns true on success and false on failure
+type Comparison = require.Comparison
+
+// PanicTestFunc defines a func that should be passed to the assert.Panics
+// and assert.NotPanics methods, and represents a simple func that takes no
+// arguments, and returns nothing.
+type PanicTestFunc = require.PanicTestFunc
+
+// ComparisonAssertionFunc is a common function prototype when comparing two values.
+//
+// Can be useful if you want to use table driven tests.
+type ComparisonAssertionFunc func(TestingT, interface{}, interface{}, ...interface{}) bool
+
+// ValueAssertionFunc is a common function prototype when validating a single value.
+//
+// Can be useful if you want to use table driven tests.
+type ValueAssertionFunc func(TestingT, interface{}, ...interface{}) bool
+
+// BoolAssertionFunc is a common function prototype when validating a bool value.
+//
+// Can be useful if you want to use table driven tests.
+type BoolAssertionFunc func(TestingT, bool, ...interface{}) bool
+
+// ErrorAssertionFunc is a common function prototype when validating an error value.
+//
+// Can be useful if you want to use table driven tests.
+type ErrorAssertionFunc func(TestingT, error, ...interface{}) bool
+
+// AnError is an error instance useful for testing. If the code does not care
+// about error specifics, and only needs to return the error for example, this
+// error should be used to make the test code more readable.
+var AnError = errors.New("assert.AnError general error for testing")
+
+// Assertions provides assertion methods around the TestingT interface.
+type Assertions struct {
+	t TestingT
+}
+
+// New makes a new Assertions object for the specified TestingT.
+func New(t TestingT) *Assertions {
+	return &Assertions{
+		t: t,
+	}
+}
+
+// errStop stops a require assertion that failed, and is recovered by run.
+var errStop = errors.New("assert: stop")
+
+// adapter lets require functions run on a TestingT: failures are reported
+// with Errorf, and stop the assertion but not the test.
+// The embedded testing.TB is nil unless t is a testing.TB.
+type adapter struct {
+	testing.TB
+	t      TestingT
+	failed bool
+}
+
+func (a *adapter) Helper() {
+	if h, ok := a.t.(tHelper); ok {
+		h.Helper()
+	}
+}
+
+func (a *adapter) Deadline() (time.Time, bool) {
+	if d, ok := a.t.(interface{ Deadline() (time.Time, bool) }); ok {
+		return d.Deadline()
+	}
+	return time.Time{}, false
+}
+
+func (a *adapter) Fail() {
+	a.failed = true
+}
+
+func (a *adapter) Failed() bool {
+	return a.failed
+}
+
+func (a *adapter) FailNow() {
+	a.failed = true
+	panic(errStop)
+}
+
+func (a *adapter) Error(args ...any) {
+	a.failed = true
+	a.t.Errorf("%s", fmt.Sprint(args...))
+}
+
+func (a *adapter) Errorf(format string, args ...any) {
+	a.failed = true
+	a.t.Errorf(format, args...)
+}
+
+func (a *adapter) Fatal(args ...any) {
+	a.Error(args...)
+	a.FailNow()
+}
+
+func (a *adapter) Fatalf(format string, args ...any) {
+	a.Errorf(format, args...)
+	a.FailNow()
+}
+
+// run runs a require assertion on t, and returns true if it succeeded.
+func run(t TestingT, assertion func(t require.TestingT)) bool {
+	a := &adapter{t: t}
+	if tb, ok := t.(testing.TB); ok {
+		a.TB = tb
+	}
+	func() {
+		defer func() {
+			if r := recover(); r != nil && r != errStop {
+				panic(r)
+			}
+		}()
+		assertion(a)
+	}()
+	return !a.failed
+}
+
+// FailNow fails test
+func FailNow(t TestingT, failureMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	Fail(t, failureMessage, msgAndArgs...)
+
+	if t, ok := t.(interface{ FailNow() }); ok {
+		t.FailNow()
+	}
+	return false
+}
+
+// IsType asserts that the specified objects are of the same type.
+func IsType(t TestingT, expectedType interface{}, object interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return run(t, func(t require.TestingT) {
+		require.IsType(t, reflect.TypeOf(expectedType), object, msgAndArgs...)
+	})
+}
+
+// ObjectsAreEqual determines if two objects are considered equal.
+//
+// This function does no assertion of any kind.
+func ObjectsAreEqual(expected, actual interface{}) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+	exp, ok := expected.([]byte)
+	if !ok {
+		return reflect.DeepEqual(expected, actual)
+	}
+	act, ok := actual.([]byte)
+	if !ok {
+		return false
+	}
+	if exp == nil || act == nil {
+		return exp == nil && act == nil
+	}
+	return bytes.Equal(exp, act)
+}
+
+// ObjectsAreEqualValues gets whether two objects are equal, or if their
+// values are equal.
+func ObjectsAreEqualValues(expected, actual interface{}) bool {
+	if ObjectsAreEqual(expected, actual) {
+		return true
+	}
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if !expectedValue.IsValid() || !actualValue.IsValid() {
+		return false
+	}
+	expectedType := expectedValue.Type()
+	actualType := actualValue.Type()
+	if !expectedType.ConvertibleTo(actualType) {
+		return false
+	}
+	if !isNumericType(expectedType) || !isNumericType(actualType) {
+		return reflect.DeepEqual(expectedValue.Convert(actualType).Interface(), actual)
+	}
+	// convert the smaller type to the larger one, so that the conversion
+	// does not overflow
+	if expectedType.Size() >= actualType.Size() {
+		return actualValue.Convert(expectedType).Interface() == expected
+	}
+	return expectedValue.Convert(actualType).Interface() == actual
+}
+
+func isNumericType(t reflect.Type) bool {
+	return t.Kind() >= reflect.Int && t.Kind() <= reflect.Complex128
+}
+
+// validateEqualArgs checks whether provided arguments can be safely used
+// in the Equal and NotEqual functions.
+func validateEqualArgs(expected, actual interface{}) error {
+	if expected == nil && actual == nil {
+		return nil
+	}
+	if isFunction(expected) || isFunction(actual) {
+		return errors.New("cannot take func type as argument")
+	}
+	return nil
+}
+
+func isFunction(arg interface{}) bool {
+	if arg == nil {
+		return false
+	}
+	return reflect.TypeOf(arg).Kind() == reflect.Func
+}
+
+// Equal asserts that two objects are equal, as ObjectsAreEqual, so that
+// values of different types, such as 1 and int64(1), are not equal.
+//
+//	assert.Equal(t, 123, 123)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses). Function equality
+// cannot be determined and will always fail.
+func Equal(t TestingT, expected interface{}, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if err := validateEqualArgs(expected, actual); err != nil {
+		return Fail(t, fmt.Sprintf("Invalid operation: %#v == %#v (%s)", expected, actual, err), msgAndArgs...)
+	}
+	if ObjectsAreEqual(expected, actual) {
+		return true
+	}
+	return run(t, func(t require.TestingT) {
+		require.Exactly(t, expected, actual, msgAndArgs...)
+	})
+}
+
+// NotEqual asserts that the specified values are NOT equal, as
+// ObjectsAreEqual.
+//
+//	assert.NotEqual(t, obj1, obj2)
+//
+// Pointer variable equality is determined based on the equality of the
+// referenced values (as opposed to the memory addresses).
+func NotEqual(t TestingT, expected interface{}, actual interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if err := validateEqualArgs(expected, actual); err != nil {
+		return Fail(t, fmt.Sprintf("Invalid operation: %#v != %#v (%s)", expected, actual, err), msgAndArgs...)
+	}
+	if ObjectsAreEqual(expected, actual) {
+		return Fail(t, fmt.Sprintf("Should not be: %#v\n", actual), msgAndArgs...)
+	}
+	return true
+}
+
+// HTTPBody is a helper that returns HTTP body of the response. It returns
+// empty string if building a new request fails.
+func HTTPBody(handler http.HandlerFunc, method string, url string, values url.Values) string {
+	w := httptest.NewRecorder()
+	if len(values) > 0 {
+		url += "?" + values.Encode()
+	}
+	req, err := http.NewRequest(method, url, http.NoBody)
+	if err != nil {
+		return ""
+	}
+	handler(w, req)
+	return w.Body.String()
+}
+
+// CallerInfo returns an array of strings containing the file and line number
+// of each stack frame leading from the current test to the assert call that
+// failed.
+func CallerInfo() []string {
+	var callers []string
+	pc := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pc[:runtime.Callers(2, pc)])
+	for {
+		frame, more := frames.Next()
+		if frame.File == "<autogenerated>" || strings.HasPrefix(frame.Function, "testing.") {
+			break
+		}
+		if frame.File != "" {
+			callers = append(callers, fmt.Sprintf("%s:%d", frame.File, frame.Line))
+		}
+		if !more {
+			break
+		}
+	}
+	return callers
+}
@@ -0,0 +1,135 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package assert_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ilius/demand/testify/assert"
+)
+
+// recorder is an assert.TestingT recording the failures.
+type recorder struct {
+	failures []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestEqualIsStrict(t *testing.T) {
+	tests := []struct {
+		expected, actual interface{}
+		equal            bool
+	}{
+		{1, 1, true},
+		{1, int64(1), false},
+		{[]byte("a"), []byte("a"), true},
+		{[]byte(nil), []byte{}, false},
+		{nil, nil, true},
+		{&struct{ A int }{1}, &struct{ A int }{1}, true},
+		{time.Unix(0, 0).UTC(), time.Unix(0, 0), false},
+	}
+	for _, tt := range tests {
+		r := &recorder{}
+		if ok := assert.Equal(r, tt.expected, tt.actual); ok != tt.equal || ok != (len(r.failures) == 0) {
+			t.Errorf("Equal(%#v, %#v) = %v with failures %q, expected %v", tt.expected, tt.actual, ok, r.failures, tt.equal)
+		}
+		if ok := assert.NotEqual(&recorder{}, tt.expected, tt.actual); ok == tt.equal {
+			t.Errorf("NotEqual(%#v, %#v) = %v", tt.expected, tt.actual, ok)
+		}
+		if equal := assert.ObjectsAreEqual(tt.expected, tt.actual); equal != tt.equal {
+			t.Errorf("ObjectsAreEqual(%#v, %#v) = %v", tt.expected, tt.actual, equal)
+		}
+	}
+
+	r := &recorder{}
+	if assert.Equal(r, func() {}, func() {}) {
+		t.Error("expected Equal of functions to fail")
+	}
+	if !assert.ObjectsAreEqualValues(int32(1), int64(1)) || assert.ObjectsAreEqualValues(1, "1") {
+		t.Error("unexpected result of ObjectsAreEqualValues")
+	}
+}
+
+// TestAPI checks that the API of testify used by common tests compiles
+// and passes.
+func TestAPI(t *testing.T) {
+	var (
+		_ assert.ComparisonAssertionFunc = assert.Equal
+		_ assert.ValueAssertionFunc      = assert.NotNil
+		_ assert.BoolAssertionFunc       = assert.True
+		_ assert.ErrorAssertionFunc      = assert.NoError
+	)
+	a := assert.New(t)
+	a.Subset([]int{1, 2, 3}, []int{2})
+	a.NotSubset([]int{1, 2, 3}, []int{4})
+	a.IsIncreasing([]int{1, 2})
+	a.IsDecreasing([]int{2, 1})
+	a.IsNonIncreasing([]int{2, 2})
+	a.IsNonDecreasing([]int{1, 1})
+	a.InEpsilon(100, 101, 0.02)
+	a.InEpsilonSlice([]float64{100}, []float64{101}, 0.02)
+	a.InDeltaSlice([]float64{1}, []float64{1.1}, 0.2)
+	a.InDeltaMapValues(map[string]float64{"a": 1}, map[string]float64{"a": 1.1}, 0.2)
+	a.WithinRange(time.Unix(5, 0), time.Unix(0, 0), time.Unix(10, 0))
+	a.NotEqualValues(1, 2)
+	a.NotImplements((*error)(nil), 1)
+	a.ErrorIs(fmt.Errorf("wrapped: %w", assert.AnError), assert.AnError)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, true)
+	}, time.Second, time.Millisecond)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "hello "+r.URL.Query().Get("name"))
+	}
+	a.Equal("hello bob", assert.HTTPBody(handler, http.MethodGet, "/", map[string][]string{"name": {"bob"}}))
+}
+
+func TestEventuallyWithTFailure(t *testing.T) {
+	r := &recorder{}
+	calls := 0
+	ok := assert.EventuallyWithT(r, func(c *assert.CollectT) {
+		calls++
+		assert.Equal(c, "ready", "starting")
+		c.FailNow()
+		panic(errors.New("not reached"))
+	}, 30*time.Millisecond, time.Millisecond)
+	if ok || len(r.failures) != 1 || calls == 0 {
+		t.Fatalf("EventuallyWithT = %v with failures %q after %d calls", ok, r.failures, calls)
+	}
+}
+
+func TestFailureCode(t *testing.T) {
+	r := &recorder{}
+	assert.Greater(r, 1, 2)
+	if len(r.failures) != 1 || !strings.Contains(r.failures[0], "Code: DMND-ORD001 (https://pkg.go.dev/github.com/ilius/demand/testify/assert#Greater)") {
+		t.Errorf("unexpected failures of Greater: %q", r.failures)
+	}
+}
@@ -0,0 +1,136 @@
+// MIT License
+
+// Copyright (c) 2024 Saeed Rasooli
+// Copyright (c) 2012-2020 Mat Ryer, Tyler Bunnell and contributors.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// compare compares two values of the same ordered kind (integers,
+// floats, strings and time.Time), returning -1, 0 or 1.
+func compare(obj1, obj2 interface{}) (int, bool) {
+	v1, v2 := reflect.ValueOf(obj1), reflect.ValueOf(obj2)
+	if !v1.IsValid() || !v2.IsValid() || v1.Kind() != v2.Kind() {
+		return 0, false
+	}
+	if t1, ok := obj1.(time.Time); ok {
+		t2, ok := obj2.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return t1.Compare(t2), true
+	}
+	switch v1.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmpValues(v1.Int(), v2.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmpValues(v1.Uint(), v2.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return cmpValues(v1.Float(), v2.Float()), true
+	case reflect.String:
+		return cmpValues(v1.String(), v2.String()), true
+	}
+	return 0, false
+}
+
+func cmpValues[T int64 | uint64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareTwoValues(t TestingT, e1 interface{}, e2 interface{}, allowed []int, failMessage string, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	if reflect.TypeOf(e1) != reflect.TypeOf(e2) {
+		return Fail(t, "Elements should be the same type", msgAndArgs...)
+	}
+	result, ok := compare(e1, e2)
+	if !ok {
+		return Fail(t, fmt.Sprintf("Can not compare type \"%T\"", e1), msgAndArgs...)
+	}
+	for _, r := range allowed {
+		if r == result {
+			return true
+		}
+	}
+	return Fail(t, failMessage, msgAndArgs...)
+}
+
+// Greater asserts that the first element is greater than the second
+func Greater(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareTwoValues(t, e1, e2, []int{1}, fmt.Sprintf("\"%v\" is not greater than \"%v\"", e1, e2), msgAndArgs...)
+}
+
+// GreaterOrEqual asserts that the first element is greater than or equal to the second
+func GreaterOrEqual(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareTwoValues(t, e1, e2, []int{1, 0}, fmt.Sprintf("\"%v\" is not greater than or equal to \"%v\"", e1, e2), msgAndArgs...)
+}
+
+// Less asserts that the first element is less than the second
+func Less(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareTwoValues(t, e1, e2, []int{-1}, fmt.Sprintf("\"%v\" is not less than \"%v\"", e1, e2), msgAndArgs...)
+}
+
+// LessOrEqual asserts that the first element is less than or equal to the second
+func LessOrEqual(t TestingT, e1 interface{}, e2 interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	return compareTwoValues(t, e1, e2, []int{-1, 0}, fmt.Sprintf("\"%v\" is not less than or equal to \"%v\"", e1, e2), msgAndArgs...)
+}
+
+// Positive asserts that the specified element is positive
+func Positive(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	zero := reflect.Zero(reflect.TypeOf(e))
+	return compareTwoValues(t, e, zero.Interface(), []int{1}, fmt.Sprintf("\"%v\" is not positive", e), msgAndArgs...)
+}
+
+// Negative asserts that the specified element is negative
+func Negative(t TestingT, e interface{}, msgAndArgs ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	zero := reflect.Zero(reflect.TypeOf(e))
+	return compareTwoValues(t, e, zero.Interface(), []int{-1}, fmt.Sprintf("\"%v\" is not negative", e), msgAndArgs...)
+}